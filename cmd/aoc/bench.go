@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/HugoKlepsch/AoC2023/internal/bench"
+	"github.com/HugoKlepsch/AoC2023/internal/solutions"
+)
+
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	day := fs.Int("day", 0, "puzzle day to benchmark; every day when omitted")
+	part := fs.Int("part", 0, "puzzle part to benchmark; both parts when omitted")
+	inputDir := fs.String("input-dir", "cmd", "directory holding the day-NN/input files; days without one are benchmarked on their example")
+	historyPath := fs.String("history", "benchmarks.json", "file recording the results of every run")
+	baseline := fs.String("baseline", "", "commit to compare against; defaults to the latest other run in the history")
+	threshold := fs.Float64("threshold", 0.1, "relative growth in time, allocations or bytes reported as a regression")
+	fs.Parse(args)
+
+	history, err := bench.LoadHistory(*historyPath)
+	if err != nil {
+		return err
+	}
+	commit, dirty := gitCommit()
+	run := bench.Run{
+		Commit:    commit,
+		Dirty:     dirty,
+		Time:      time.Now().UTC(),
+		GoVersion: runtime.Version(),
+		Results:   make(map[string]bench.Result),
+	}
+
+	var names []string
+	for _, s := range solutions.All {
+		if *day != 0 && s.Day != *day || *part != 0 && s.Part != *part {
+			continue
+		}
+		path, err := benchInput(*inputDir, s)
+		if err != nil {
+			return err
+		}
+		input, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%s: benchmarking on %s\n", s, path)
+		result, err := bench.Measure(s.Solve, filepath.ToSlash(path), input)
+		if err != nil {
+			return fmt.Errorf("%s: %w", s, err)
+		}
+		names = append(names, s.String())
+		run.Results[s.String()] = result
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no solver for day %d part %d", *day, *part)
+	}
+
+	base, ok := history.Baseline(run, *baseline)
+	if !ok && *baseline != "" {
+		return fmt.Errorf("no run of %s in %s", *baseline, *historyPath)
+	}
+	if err := history.Record(run); err != nil {
+		return err
+	}
+
+	if ok {
+		fmt.Printf("%s compared to %s (%s)\n\n", run.Label(), base.Label(), base.Time.Format(time.DateOnly))
+	} else {
+		fmt.Printf("%s, no baseline to compare to\n\n", run.Label())
+	}
+	regressions := printComparison(bench.Compare(names, base, run), *threshold)
+	if regressions > 0 {
+		fmt.Printf("\n%d of %d solvers regressed by more than %.0f%%\n", regressions, len(names), *threshold*100)
+	}
+	return nil
+}
+
+// benchInput is the private input of s, or its example when there isn't one.
+func benchInput(inputDir string, s solutions.Solution) (string, error) {
+	path := inputPath(inputDir, s.Day)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	return filepath.Join("internal", fmt.Sprintf("day%02d", s.Day), fmt.Sprintf("p%d", s.Part), "testdata", "example.txt"), nil
+}
+
+// gitCommit returns the commit checked out and whether the working tree has
+// changes on top of it, or "unknown" outside a git checkout.
+func gitCommit() (string, bool) {
+	out, err := exec.Command("git", "rev-parse", "--short=12", "HEAD").Output()
+	if err != nil {
+		return "unknown", false
+	}
+	status, err := exec.Command("git", "status", "--porcelain", "--untracked-files=no").Output()
+	return strings.TrimSpace(string(out)), err == nil && len(bytes.TrimSpace(status)) > 0
+}
+
+// printComparison prints a table of changes, marking the regressions, and
+// returns how many there were.
+func printComparison(changes []bench.Change, threshold float64) int {
+	mark := "REGRESSION"
+	if fi, err := os.Stdout.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+		mark = "\x1b[1;31mREGRESSION\x1b[0m"
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "solution\tns/op\tdelta\tB/op\tdelta\tallocs/op\tdelta\t\t")
+	regressions := 0
+	for _, c := range changes {
+		note := ""
+		if c.Regressed(threshold) {
+			note = mark
+			regressions++
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\t%d\t%s\t\t%s\n",
+			c.Name,
+			c.New.NsPerOp, delta(c, c.Old.NsPerOp, c.New.NsPerOp),
+			c.New.BytesPerOp, delta(c, c.Old.BytesPerOp, c.New.BytesPerOp),
+			c.New.AllocsPerOp, delta(c, c.Old.AllocsPerOp, c.New.AllocsPerOp),
+			note)
+	}
+	w.Flush()
+	return regressions
+}
+
+func delta(c bench.Change, old, new int64) string {
+	if !c.HasOld {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f%%", bench.Delta(old, new)*100)
+}
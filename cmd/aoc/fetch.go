@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/client"
+)
+
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	day := fs.Int("day", 0, "puzzle day to fetch the input for")
+	baseURL := fs.String("base-url", "", "site to fetch from; defaults to $"+client.BaseURLEnv+" or "+client.DefaultBaseURL)
+	cacheDir := fs.String("cache-dir", "", "directory to cache inputs in; defaults to the user cache directory")
+	output := fs.String("output", "", "where to copy the input, or - for stdout; defaults to the day's file under -input-dir")
+	inputDir := fs.String("input-dir", "cmd", "directory holding the day-NN/input files")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return errors.New("--day must be between 1 and 25")
+	}
+
+	c, err := newClient(*baseURL, *cacheDir)
+	if err != nil {
+		return err
+	}
+	input, err := c.Input(*day)
+	if err != nil {
+		return err
+	}
+
+	if *output == "-" {
+		_, err := os.Stdout.Write(input)
+		return err
+	}
+	path := *output
+	if path == "" {
+		path = inputPath(*inputDir, *day)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, input, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("day %02d: input cached at %s, written to %s\n", *day, c.InputPath(*day), path)
+	return nil
+}
+
+// newClient returns a client for the configured session, with the base URL
+// and cache directory overridden when they are not empty.
+func newClient(baseURL, cacheDir string) (*client.Client, error) {
+	session, err := client.LoadSession()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(session)
+	if err != nil {
+		return nil, err
+	}
+	if baseURL != "" {
+		c.BaseURL = strings.TrimSuffix(baseURL, "/")
+	}
+	if cacheDir != "" {
+		c.CacheDir = cacheDir
+	}
+	return c, nil
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/client"
+)
+
+func TestNewClientTrimsBaseURL(t *testing.T) {
+	t.Setenv(client.SessionEnv, "secret")
+	for _, baseURL := range []string{"https://example.com", "https://example.com/"} {
+		c, err := newClient(baseURL, t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.BaseURL != "https://example.com" {
+			t.Errorf("newClient(%q).BaseURL = %q, want https://example.com", baseURL, c.BaseURL)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Command aoc runs the Advent of Code 2023 solvers.
+//
+// Usage:
+//
+//	aoc run --day 7 --part 2 --input path
+//	aoc run --all
+//	aoc fetch --day 7
+//	aoc submit --day 7 --part 2
+//	aoc bench --day 5
+//	aoc new --day 11
+//	aoc new --day 11 --from p1
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{name: "run", summary: "run solvers and print their answers", run: runCmd},
+	{name: "fetch", summary: "download a day's puzzle input", run: fetchCmd},
+	{name: "submit", summary: "solve a puzzle and submit the answer", run: submitCmd},
+	{name: "bench", summary: "benchmark solvers and compare against an earlier run", run: benchCmd},
+	{name: "new", summary: "scaffold a new day from the templates", run: newCmd},
+}
+
+// errFailed is returned by a command that has already reported its failures.
+var errFailed = errors.New("failed")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: aoc <command> [flags]\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", c.name, c.summary)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	for _, c := range commands {
+		if c.name != os.Args[1] {
+			continue
+		}
+		if err := c.run(os.Args[2:]); err != nil {
+			if !errors.Is(err, errFailed) {
+				fmt.Fprintf(os.Stderr, "aoc %s: %v\n", c.name, err)
+			}
+			os.Exit(1)
+		}
+		return
+	}
+	usage()
+	os.Exit(2)
+}
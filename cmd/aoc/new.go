@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const modulePath = "github.com/HugoKlepsch/AoC2023"
+
+//go:embed templates
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// scaffoldFile is a file aoc new writes. pristine is what a fresh scaffold
+// puts at path, which is safe to replace; nil when nothing there is.
+type scaffoldFile struct {
+	path     string
+	content  []byte
+	pristine []byte
+}
+
+var (
+	packageRegex = regexp.MustCompile(`(?m)^package p1$`)
+	importRegex  = regexp.MustCompile(`(?m)^\tday(\d+)p(\d+) ".*"\n`)
+	entryRegex   = regexp.MustCompile(`(?m)^\t\{Day: (\d+), Part: (\d+), .*\n`)
+	answerRegex  = regexp.MustCompile(`(?m)^(\d+) (\d+) .*$`)
+)
+
+func newCmd(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	day := fs.Int("day", 0, "puzzle day to create")
+	from := fs.String("from", "", "clone this part of the day into p2 instead of creating the day; only p1 is supported")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return errors.New("--day must be between 1 and 25")
+	}
+	if _, err := os.Stat("go.mod"); err != nil {
+		return errors.New("aoc new must be run from the root of the repository")
+	}
+
+	var files []scaffoldFile
+	var err error
+	switch *from {
+	case "":
+		for part := 1; part <= 2; part++ {
+			partFiles, err := templateFiles(*day, part)
+			if err != nil {
+				return err
+			}
+			files = append(files, partFiles...)
+		}
+	case "p1":
+		files, err = cloneFiles(*day)
+	default:
+		return fmt.Errorf("--from %s: only p1 can be cloned", *from)
+	}
+	if err != nil {
+		return err
+	}
+
+	writes, err := pendingWrites(files)
+	if err != nil {
+		return err
+	}
+	for _, f := range writes {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(f.path, f.content, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", f.path)
+	}
+	return register(*day)
+}
+
+// pendingWrites returns the files that need writing, or an error when one
+// would overwrite existing code. Everything is checked before anything is
+// written, so a refusal leaves no half scaffolded day behind.
+func pendingWrites(files []scaffoldFile) ([]scaffoldFile, error) {
+	var writes []scaffoldFile
+	for _, f := range files {
+		existing, err := os.ReadFile(f.path)
+		if errors.Is(err, fs.ErrNotExist) {
+			writes = append(writes, f)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(existing, f.content) {
+			continue
+		}
+		if f.pristine == nil || !bytes.Equal(existing, f.pristine) {
+			return nil, fmt.Errorf("%s already exists; refusing to overwrite it", f.path)
+		}
+		writes = append(writes, f)
+	}
+	return writes, nil
+}
+
+func dayDir(day, part int) string {
+	return filepath.Join("internal", fmt.Sprintf("day%02d", day), fmt.Sprintf("p%d", part))
+}
+
+// templateFiles is a fresh scaffold of one part of a day: its solver, a test
+// of the solver on the example, an empty example and the binary.
+func templateFiles(day, part int) ([]scaffoldFile, error) {
+	dir := dayDir(day, part)
+	files := []struct{ template, path string }{
+		{"solver.go.tmpl", filepath.Join(dir, fmt.Sprintf("p%d.go", part))},
+		{"solver_test.go.tmpl", filepath.Join(dir, fmt.Sprintf("p%d_test.go", part))},
+		{"main.go.tmpl", filepath.Join("cmd", fmt.Sprintf("day-%02d", day), fmt.Sprintf("p%d", part), "main.go")},
+	}
+
+	scaffold := []scaffoldFile{{path: filepath.Join(dir, "testdata", "example.txt"), content: []byte{}, pristine: []byte{}}}
+	for _, f := range files {
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, f.template, struct{ Day, Part int }{day, part}); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.template, err)
+		}
+		scaffold = append(scaffold, scaffoldFile{path: f.path, content: src, pristine: src})
+	}
+	return scaffold, nil
+}
+
+// cloneFiles copies the solver and examples of p1 of day into p2, replacing
+// p2's scaffold. p2 keeps its own test, since p1's expects p1's answer.
+func cloneFiles(day int) ([]scaffoldFile, error) {
+	p1Dir, p2Dir := dayDir(day, 1), dayDir(day, 2)
+	if _, err := os.Stat(filepath.Join(p1Dir, "p1.go")); err != nil {
+		return nil, fmt.Errorf("no p1 to clone: %w", err)
+	}
+
+	fresh, err := templateFiles(day, 2)
+	if err != nil {
+		return nil, err
+	}
+	pristine := make(map[string][]byte)
+	var files []scaffoldFile
+	for _, f := range fresh {
+		pristine[f.path] = f.pristine
+		if f.path == filepath.Join(p2Dir, "p2.go") || f.path == filepath.Join(p2Dir, "testdata", "example.txt") {
+			continue
+		}
+		if _, err := os.Stat(f.path); errors.Is(err, fs.ErrNotExist) {
+			files = append(files, f)
+		}
+	}
+
+	err = filepath.WalkDir(p1Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		rel, err := filepath.Rel(p1Dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if rel == "p1.go" {
+			rel = "p2.go"
+		}
+		if filepath.Ext(rel) == ".go" {
+			content = packageRegex.ReplaceAll(content, []byte("package p2"))
+		}
+		target := filepath.Join(p2Dir, rel)
+		files = append(files, scaffoldFile{path: target, content: content, pristine: pristine[target]})
+		return nil
+	})
+	return files, err
+}
+
+// register adds both parts of day to the solutions registry and gives them
+// placeholder example answers in the golden suite.
+func register(day int) error {
+	path := filepath.Join("internal", "solutions", "solutions.go")
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	registry := string(src)
+	for part := 1; part <= 2; part++ {
+		alias := fmt.Sprintf("day%02dp%d", day, part)
+		if strings.Contains(registry, alias+".Solve") {
+			continue
+		}
+		importPath := modulePath + "/" + filepath.ToSlash(dayDir(day, part))
+		registry = insertSorted(registry, importRegex, day, part, fmt.Sprintf("\t%s %q\n", alias, importPath))
+		registry = insertSorted(registry, entryRegex, day, part, fmt.Sprintf("\t{Day: %d, Part: %d, Solve: %s.Solve},\n", day, part, alias))
+	}
+	formatted, err := format.Source([]byte(registry))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if !bytes.Equal(formatted, src) {
+		if err := os.WriteFile(path, formatted, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("registered day %d in %s\n", day, path)
+	}
+
+	path = filepath.Join("internal", "solutions", "testdata", "answers.txt")
+	answers, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	updated := addPlaceholders(answers, day)
+	if bytes.Equal(updated, answers) {
+		return nil
+	}
+	if err := os.WriteFile(path, updated, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("added placeholder answers for day %d to %s\n", day, path)
+	return nil
+}
+
+// addPlaceholders gives each part of day with no answers recorded a
+// placeholder example answer. They go after the day's last answer, or at the
+// end in a block of their own when the day has none.
+func addPlaceholders(answers []byte, day int) []byte {
+	recorded := make(map[int]bool)
+	end := -1
+	for _, m := range answerRegex.FindAllSubmatchIndex(answers, -1) {
+		if d, _ := strconv.Atoi(string(answers[m[2]:m[3]])); d == day {
+			p, _ := strconv.Atoi(string(answers[m[4]:m[5]]))
+			recorded[p] = true
+			end = m[1]
+		}
+	}
+
+	var missing []byte
+	for part := 1; part <= 2; part++ {
+		if !recorded[part] {
+			missing = fmt.Appendf(missing, "\n%d %d example.txt ?", day, part)
+		}
+	}
+	if len(missing) == 0 {
+		return answers
+	}
+	if end < 0 {
+		return append(append(answers, missing...), '\n')
+	}
+	updated := append(append([]byte(nil), answers[:end]...), missing...)
+	return append(updated, answers[end:]...)
+}
+
+// insertSorted inserts line into src among the lines matching re, whose first
+// two groups are a day and part, keeping them in calendar order.
+func insertSorted(src string, re *regexp.Regexp, day, part int, line string) string {
+	matches := re.FindAllStringSubmatchIndex(src, -1)
+	if len(matches) == 0 {
+		return src
+	}
+	i := sort.Search(len(matches), func(i int) bool {
+		m := matches[i]
+		d, _ := strconv.Atoi(src[m[2]:m[3]])
+		p, _ := strconv.Atoi(src[m[4]:m[5]])
+		return d > day || d == day && p > part
+	})
+	at := matches[len(matches)-1][1]
+	if i < len(matches) {
+		at = matches[i][0]
+	}
+	return src[:at] + line + src[at:]
+}
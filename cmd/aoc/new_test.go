@@ -0,0 +1,158 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testRegistry = `package solutions
+
+import (
+	day01p1 "github.com/HugoKlepsch/AoC2023/internal/day01/p1"
+)
+
+var All = []Solution{
+	{Day: 1, Part: 1, Solve: day01p1.Solve},
+}
+`
+
+// newTestRepo makes a bare repository holding files, with a registry and
+// golden answers for day 1 part 1, and moves into it for the rest of the test.
+func newTestRepo(t *testing.T, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	base := map[string]string{
+		"go.mod":                          "module " + modulePath + "\n",
+		"internal/solutions/solutions.go": testRegistry,
+		"internal/solutions/testdata/answers.txt": "# answers\n\n1 1 example.txt 142\n",
+	}
+	for path, content := range files {
+		base[path] = content
+	}
+	for path, content := range base {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func TestNewScaffoldsDay(t *testing.T) {
+	newTestRepo(t, nil)
+	if err := newCmd([]string{"--day", "3"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{
+		"internal/day03/p1/p1.go",
+		"internal/day03/p1/p1_test.go",
+		"internal/day03/p1/testdata/example.txt",
+		"internal/day03/p2/p2.go",
+		"internal/day03/p2/p2_test.go",
+		"internal/day03/p2/testdata/example.txt",
+		"cmd/day-03/p1/main.go",
+		"cmd/day-03/p2/main.go",
+	} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("aoc new didn't write %s: %v", path, err)
+		}
+	}
+	if test := readFile(t, "internal/day03/p2/p2_test.go"); !strings.Contains(test, "package p2") || !strings.Contains(test, "Solve(f)") {
+		t.Errorf("p2_test.go doesn't test p2's solver:\n%s", test)
+	}
+
+	registry := readFile(t, "internal/solutions/solutions.go")
+	for _, want := range []string{"day03p1.Solve", "day03p2.Solve", modulePath + "/internal/day03/p2"} {
+		if !strings.Contains(registry, want) {
+			t.Errorf("solutions.go is missing %s:\n%s", want, registry)
+		}
+	}
+	if got, want := readFile(t, "internal/solutions/testdata/answers.txt"), "# answers\n\n1 1 example.txt 142\n\n3 1 example.txt ?\n3 2 example.txt ?\n"; got != want {
+		t.Errorf("answers.txt = %q, want %q", got, want)
+	}
+}
+
+func TestNewFromP1(t *testing.T) {
+	newTestRepo(t, map[string]string{
+		"internal/day01/p1/p1.go":                "package p1\n\nfunc Solve() {}\n",
+		"internal/day01/p1/p1_test.go":           "package p1\n",
+		"internal/day01/p1/testdata/example.txt": "1abc2\n",
+	})
+	if err := newCmd([]string{"--day", "1", "--from", "p1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := readFile(t, "internal/day01/p2/p2.go"); got != "package p2\n\nfunc Solve() {}\n" {
+		t.Errorf("p2.go = %q, want p1.go's solver in package p2", got)
+	}
+	if got := readFile(t, "internal/day01/p2/testdata/example.txt"); got != "1abc2\n" {
+		t.Errorf("example.txt = %q, want p1's example", got)
+	}
+	if test := readFile(t, "internal/day01/p2/p2_test.go"); !strings.Contains(test, "package p2") {
+		t.Errorf("p2_test.go isn't p2's own scaffolded test:\n%s", test)
+	}
+
+	// Part 1 already has an answer, so only part 2 gets a placeholder, next
+	// to it.
+	if got, want := readFile(t, "internal/solutions/testdata/answers.txt"), "# answers\n\n1 1 example.txt 142\n1 2 example.txt ?\n"; got != want {
+		t.Errorf("answers.txt = %q, want %q", got, want)
+	}
+	if registry := readFile(t, "internal/solutions/solutions.go"); strings.Count(registry, "day01p1.Solve") != 1 || !strings.Contains(registry, "day01p2.Solve") {
+		t.Errorf("solutions.go doesn't register each part of day 1 once:\n%s", registry)
+	}
+}
+
+func TestNewRegisteredDay(t *testing.T) {
+	newTestRepo(t, nil)
+	if err := newCmd([]string{"--day", "3"}); err != nil {
+		t.Fatal(err)
+	}
+	registry := readFile(t, "internal/solutions/solutions.go")
+	answers := readFile(t, "internal/solutions/testdata/answers.txt")
+
+	// Scaffolding the same day again changes nothing.
+	if err := newCmd([]string{"--day", "3"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := readFile(t, "internal/solutions/solutions.go"); got != registry {
+		t.Errorf("solutions.go changed on a second aoc new:\n%s", got)
+	}
+	if got := readFile(t, "internal/solutions/testdata/answers.txt"); got != answers {
+		t.Errorf("answers.txt changed on a second aoc new: %q", got)
+	}
+
+	// Once a solver has been written, aoc new refuses to touch the day.
+	solver := "package p1\n\nfunc Solve() {}\n"
+	if err := os.WriteFile("internal/day03/p1/p1.go", []byte(solver), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := newCmd([]string{"--day", "3"}); err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Errorf("aoc new over a written solver = %v, want a refusal", err)
+	}
+	if got := readFile(t, "internal/day03/p1/p1.go"); got != solver {
+		t.Errorf("p1.go = %q after a refusal, want it untouched", got)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/solutions"
+)
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	day := fs.Int("day", 0, "puzzle day to run")
+	part := fs.Int("part", 0, "puzzle part to run; both parts when omitted")
+	all := fs.Bool("all", false, "run every implemented day and part")
+	input := fs.String("input", "", "puzzle input file, or - for stdin; defaults to the day's file under -input-dir")
+	inputDir := fs.String("input-dir", "cmd", "directory holding the day-NN/input files")
+	verbose := fs.Bool("v", false, "print solver progress to stderr")
+	fs.Parse(args)
+
+	if *verbose {
+		aoc.Debug = os.Stderr
+	}
+
+	var selected []solutions.Solution
+	switch {
+	case *all && (*day != 0 || *input != ""):
+		return errors.New("--all cannot be combined with --day or --input")
+	case *all:
+		selected = solutions.All
+	case *day != 0:
+		for _, s := range solutions.All {
+			if s.Day == *day && (*part == 0 || s.Part == *part) {
+				selected = append(selected, s)
+			}
+		}
+		if len(selected) == 0 {
+			return fmt.Errorf("no solver for day %d part %d", *day, *part)
+		}
+	default:
+		return errors.New("one of --day or --all is required")
+	}
+
+	failed := 0
+	for _, s := range selected {
+		path := *input
+		if path == "" {
+			path = inputPath(*inputDir, s.Day)
+		}
+		answer, elapsed, err := solve(s, path)
+		if err != nil {
+			aoc.SetFile(err, inputName(path))
+			fmt.Fprintf(os.Stderr, "%s: ", s)
+			aoc.RenderError(os.Stderr, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s: %s (%s)\n", s, answer, elapsed.Round(time.Microsecond))
+	}
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "%d of %d solvers failed\n", failed, len(selected))
+		return errFailed
+	}
+	return nil
+}
+
+// inputPath is where a day's puzzle input lives, next to that day's binaries.
+func inputPath(dir string, day int) string {
+	return filepath.Join(dir, fmt.Sprintf("day-%02d", day), "input")
+}
+
+// inputName is how errors name the input at path, which is - for stdin.
+func inputName(path string) string {
+	if path == "-" {
+		return "<stdin>"
+	}
+	return path
+}
+
+func solve(s solutions.Solution, path string) (string, time.Duration, error) {
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", 0, err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	start := time.Now()
+	answer, err := s.Solve(in)
+	return answer, time.Since(start), err
+}
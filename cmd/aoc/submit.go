@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/client"
+	"github.com/HugoKlepsch/AoC2023/internal/solutions"
+)
+
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	day := fs.Int("day", 0, "puzzle day to submit")
+	part := fs.Int("part", 0, "puzzle part to submit")
+	input := fs.String("input", "", "puzzle input file, or - for stdin; defaults to the day's file under -input-dir")
+	inputDir := fs.String("input-dir", "cmd", "directory holding the day-NN/input files")
+	baseURL := fs.String("base-url", "", "site to submit to; defaults to $"+client.BaseURLEnv+" or "+client.DefaultBaseURL)
+	cacheDir := fs.String("cache-dir", "", "directory holding the submission history; defaults to the user cache directory")
+	fs.Parse(args)
+
+	s, ok := solutions.Find(*day, *part)
+	if !ok {
+		return fmt.Errorf("no solver for day %d part %d", *day, *part)
+	}
+	path := *input
+	if path == "" {
+		path = inputPath(*inputDir, s.Day)
+	}
+
+	answer, elapsed, err := solve(s, path)
+	if err != nil {
+		aoc.SetFile(err, inputName(path))
+		aoc.RenderError(os.Stderr, err)
+		return errFailed
+	}
+	fmt.Printf("%s: %s (%s)\n", s, answer, elapsed.Round(time.Microsecond))
+
+	c, err := newClient(*baseURL, *cacheDir)
+	if err != nil {
+		return err
+	}
+	history, err := client.LoadHistory(c.HistoryPath())
+	if err != nil {
+		return err
+	}
+	if err := history.Check(s.Day, s.Part, answer, time.Now()); err != nil {
+		return err
+	}
+
+	sub, err := c.Submit(s.Day, s.Part, answer)
+	if err != nil {
+		return err
+	}
+	if err := history.Record(sub); err != nil {
+		return fmt.Errorf("recording submission: %w", err)
+	}
+
+	fmt.Printf("%s: %s\n", sub.Outcome, sub.Message)
+	if sub.Outcome != client.OutcomeCorrect {
+		return errors.New("answer not accepted")
+	}
+	return nil
+}
@@ -1,41 +1,10 @@
 package main
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day01/p1"
 )
 
 func main() {
-
-	readFile, err := os.Open("input")
-	if err != nil {
-		fmt.Println(err)
-		panic(err)
-	}
-	defer readFile.Close()
-	fileScanner := bufio.NewScanner(readFile)
-	fileScanner.Split(bufio.ScanLines)
-
-	total := 0
-
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		digits := []int{}
-		for i := 0; i < len(line); i++ {
-			c := line[i]
-			if c >= '0' && c <= '9' {
-				digits = append(digits, int(c-48))
-			}
-		}
-		fmt.Printf("Digits: %v\n", digits)
-		code := 10*digits[0] + digits[len(digits)-1]
-		total += code
-	}
-	if err = fileScanner.Err(); err != nil {
-		fmt.Println(err)
-		panic(err)
-	}
-
-	fmt.Printf("Sum: %d\n", total)
+	aoc.Main(p1.Solve)
 }
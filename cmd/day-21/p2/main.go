@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day21/p2"
+)
+
+func main() {
+	aoc.Main(p2.Solve)
+}
@@ -0,0 +1,22 @@
+// Command dot prints the day 25 wiring diagram as a Graphviz graph, with the
+// minimum cut marked, for looking at:
+//
+//	go run ./cmd/day-25/dot cmd/day-25/input | dot -Tsvg > wiring.svg
+package main
+
+import (
+	"io"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day25"
+)
+
+func main() {
+	aoc.Main(func(r io.Reader) (string, error) {
+		graph, err := day25.Parse(r)
+		if err != nil {
+			return "", err
+		}
+		return graph.DOT(graph.MinCut()), nil
+	})
+}
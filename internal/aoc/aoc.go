@@ -0,0 +1,56 @@
+// Package aoc holds the pieces shared by every puzzle solver: the Solver
+// contract, the debug output they chatter to, and the main function the
+// per-day binaries are built from.
+package aoc
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Solver solves one part of one day's puzzle. It reads the puzzle input from r
+// and returns the answer exactly as it would be submitted.
+type Solver func(r io.Reader) (string, error)
+
+// Debug receives the progress output of the solvers. It is discarded unless
+// verbose output was asked for.
+var Debug io.Writer = io.Discard
+
+// Debugf writes solver progress output to Debug.
+func Debugf(format string, a ...any) {
+	fmt.Fprintf(Debug, format, a...)
+}
+
+// Main runs solve against the input file named on the command line, or stdin
+// when there isn't one, and prints the answer. It is the whole body of the
+// cmd/day-NN/pN binaries.
+func Main(solve Solver) {
+	verbose := flag.Bool("v", false, "print solver progress to stderr")
+	flag.Parse()
+	if *verbose {
+		Debug = os.Stderr
+	}
+
+	var in io.Reader = os.Stdin
+	name := "<stdin>"
+	if flag.NArg() > 0 {
+		name = flag.Arg(0)
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	answer, err := solve(in)
+	if err != nil {
+		SetFile(err, name)
+		RenderError(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(answer)
+}
@@ -0,0 +1,90 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports puzzle input that a solver could not make sense of.
+type ParseError struct {
+	// File names the input. Solvers only see a reader, so it is filled in by
+	// whoever opened the input.
+	File string
+	// Line is the 1-based line number at fault.
+	Line int
+	// Column is the 1-based byte column at fault, or 0 when the whole line is.
+	Column int
+	// Text is the content of the line at fault, without its newline.
+	Text string
+	// Expected describes what the parser wanted to find.
+	Expected string
+	// Err is the underlying cause, if any.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	file := e.File
+	if file == "" {
+		file = "input"
+	}
+	pos := fmt.Sprintf("%s:%d", file, e.Line)
+	if e.Column > 0 {
+		pos += ":" + strconv.Itoa(e.Column)
+	}
+
+	switch {
+	case e.Expected != "" && e.Err != nil:
+		return fmt.Sprintf("%s: expected %s: %v", pos, e.Expected, e.Err)
+	case e.Expected != "":
+		return fmt.Sprintf("%s: expected %s", pos, e.Expected)
+	case e.Err != nil:
+		return fmt.Sprintf("%s: %v", pos, e.Err)
+	}
+	return pos + ": malformed input"
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// SetFile records the input's name on any ParseError wrapped in err.
+func SetFile(err error, file string) {
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.File == "" {
+		pe.File = file
+	}
+}
+
+// RenderError writes err to w. When err carries a ParseError, the offending
+// input line follows, with a caret under the column at fault or the whole line
+// underlined when no column is known.
+func RenderError(w io.Writer, err error) {
+	fmt.Fprintln(w, err)
+
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line < 1 {
+		return
+	}
+
+	gutter := strconv.Itoa(pe.Line)
+	fmt.Fprintf(w, " %s | %s\n", gutter, pe.Text)
+
+	var marker strings.Builder
+	if pe.Column > 0 {
+		// Keep tabs so the caret lines up with the text above it.
+		for i := 0; i < pe.Column-1; i++ {
+			if i < len(pe.Text) && pe.Text[i] == '\t' {
+				marker.WriteByte('\t')
+			} else {
+				marker.WriteByte(' ')
+			}
+		}
+		marker.WriteByte('^')
+	} else {
+		marker.WriteString(strings.Repeat("~", max(len(pe.Text), 1)))
+	}
+	fmt.Fprintf(w, " %s | %s\n", strings.Repeat(" ", len(gutter)), marker.String())
+}
@@ -0,0 +1,79 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseErrorError(t *testing.T) {
+	_, atoiErr := strconv.Atoi("x")
+	tests := []struct {
+		err  *ParseError
+		want string
+	}{
+		{
+			err:  &ParseError{Line: 3, Column: 7, Expected: "number"},
+			want: "input:3:7: expected number",
+		},
+		{
+			err:  &ParseError{File: "cmd/day-04/input", Line: 3, Expected: "number", Err: atoiErr},
+			want: `cmd/day-04/input:3: expected number: strconv.Atoi: parsing "x": invalid syntax`,
+		},
+		{
+			err:  &ParseError{Line: 1, Column: 2, Err: atoiErr},
+			want: `input:1:2: strconv.Atoi: parsing "x": invalid syntax`,
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRenderError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "caret",
+			err:  &ParseError{File: "in", Line: 12, Column: 5, Text: "1 2 x 4", Expected: "number"},
+			want: "in:12:5: expected number\n" +
+				" 12 | 1 2 x 4\n" +
+				"    |     ^\n",
+		},
+		{
+			name: "whole line",
+			err:  fmt.Errorf("wrapped: %w", &ParseError{File: "in", Line: 2, Text: "abc", Expected: "digit"}),
+			want: "wrapped: in:2: expected digit\n" +
+				" 2 | abc\n" +
+				"   | ~~~\n",
+		},
+		{
+			name: "tabs",
+			err:  &ParseError{File: "in", Line: 1, Column: 3, Text: "\t\tx"},
+			want: "in:1:3: malformed input\n" +
+				" 1 | \t\tx\n" +
+				"   | \t\t^\n",
+		},
+		{
+			name: "not a parse error",
+			err:  errors.New("boom"),
+			want: "boom\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			RenderError(&b, tt.err)
+			if got := b.String(); got != tt.want {
+				t.Errorf("RenderError() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
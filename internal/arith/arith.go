@@ -0,0 +1,58 @@
+// Package arith holds the integer arithmetic the puzzles lean on: divisors,
+// multiples and sequences.
+package arith
+
+import "slices"
+
+// GCD returns the greatest common divisor of a and b, by Euclid's algorithm.
+// It is never negative, and GCD(0, 0) is 0.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM returns the least common multiple of ns, the first time cycles of those
+// lengths all line up again. The LCM of no numbers is 1.
+func LCM(ns ...int) int {
+	l := 1
+	for _, n := range ns {
+		if n == 0 {
+			return 0
+		}
+		l = l / GCD(l, n) * n
+		if l < 0 {
+			l = -l
+		}
+	}
+	return l
+}
+
+// Extrapolate returns the value that comes after values, taking them to be the
+// start of a polynomial sequence: one whose differences, or differences of
+// differences and so on, eventually stop changing.
+func Extrapolate(values []int) int {
+	return ExtrapolateTo(values, len(values))
+}
+
+// ExtrapolateTo returns the value at index n of the polynomial sequence that
+// starts with values. It sums Newton's forward differences, so n can be far
+// beyond the end of values without stepping there.
+func ExtrapolateTo(values []int, n int) int {
+	diffs := slices.Clone(values)
+	sum, binomial := 0, 1
+	for k := 0; len(diffs) > 0 && binomial != 0; k++ {
+		sum += binomial * diffs[0]
+		// binomial goes from n choose k to n choose k+1.
+		binomial = binomial * (n - k) / (k + 1)
+		for i := 0; i+1 < len(diffs); i++ {
+			diffs[i] = diffs[i+1] - diffs[i]
+		}
+		diffs = diffs[:len(diffs)-1]
+	}
+	return sum
+}
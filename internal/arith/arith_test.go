@@ -0,0 +1,65 @@
+package arith
+
+import "testing"
+
+func TestGCD(t *testing.T) {
+	tests := []struct{ a, b, want int }{
+		{12, 18, 6},
+		{18, 12, 6},
+		{7, 13, 1},
+		{0, 5, 5},
+		{5, 0, 5},
+		{0, 0, 0},
+		{-4, 6, 2},
+	}
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		ns   []int
+		want int
+	}{
+		{nil, 1},
+		{[]int{4}, 4},
+		{[]int{4, 6}, 12},
+		{[]int{2, 3, 4, 5}, 60},
+		{[]int{3, 0}, 0},
+		{[]int{269 * 71, 269 * 73, 269 * 79}, 269 * 71 * 73 * 79},
+	}
+	for _, tt := range tests {
+		if got := LCM(tt.ns...); got != tt.want {
+			t.Errorf("LCM(%v) = %d, want %d", tt.ns, got, tt.want)
+		}
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+	// The histories from day 9.
+	tests := []struct {
+		values []int
+		want   int
+	}{
+		{[]int{0, 3, 6, 9, 12, 15}, 18},
+		{[]int{1, 3, 6, 10, 15, 21}, 28},
+		{[]int{10, 13, 16, 21, 30, 45}, 68},
+		{[]int{45, 30, 21, 16, 13, 10}, 5},
+		{[]int{7}, 7},
+	}
+	for _, tt := range tests {
+		if got := Extrapolate(tt.values); got != tt.want {
+			t.Errorf("Extrapolate(%v) = %d, want %d", tt.values, got, tt.want)
+		}
+	}
+
+	quadratic := func(n int) int { return 3*n*n - 2*n + 5 }
+	for _, n := range []int{0, 2, 3, 10, 202300} {
+		if got := ExtrapolateTo([]int{quadratic(0), quadratic(1), quadratic(2)}, n); got != quadratic(n) {
+			t.Errorf("ExtrapolateTo(quadratic, %d) = %d, want %d", n, got, quadratic(n))
+		}
+	}
+}
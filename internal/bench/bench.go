@@ -0,0 +1,185 @@
+// Package bench measures solvers and keeps a history of the measurements,
+// keyed by git commit, so a run can be compared against an earlier baseline.
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+// Result is the cost of one call of a solver.
+type Result struct {
+	// Input names the input the solver was measured on, since results on
+	// different inputs can't be compared.
+	Input       string `json:"input"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+}
+
+// Func returns a benchmark calling solve on input once per iteration.
+func Func(solve aoc.Solver, input []byte) func(b *testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := solve(bytes.NewReader(input)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// Measure benchmarks solve on input, named name in the result.
+func Measure(solve aoc.Solver, name string, input []byte) (Result, error) {
+	// testing.Benchmark swallows failures, so find them with a plain call.
+	if _, err := solve(bytes.NewReader(input)); err != nil {
+		return Result{}, err
+	}
+	r := testing.Benchmark(Func(solve, input))
+	return Result{
+		Input:       name,
+		NsPerOp:     r.NsPerOp(),
+		AllocsPerOp: r.AllocsPerOp(),
+		BytesPerOp:  r.AllocedBytesPerOp(),
+	}, nil
+}
+
+// Run is one benchmarking of the solvers at a commit.
+type Run struct {
+	Commit string `json:"commit"`
+	// Dirty is set when the working tree had uncommitted changes.
+	Dirty     bool      `json:"dirty,omitempty"`
+	Time      time.Time `json:"time"`
+	GoVersion string    `json:"go_version"`
+	// Results is keyed by solution, as in "day 05 part 2".
+	Results map[string]Result `json:"results"`
+}
+
+// Label names the code the run measured.
+func (r Run) Label() string {
+	if r.Dirty {
+		return r.Commit + "+dirty"
+	}
+	return r.Commit
+}
+
+// History is every run recorded in a JSON file, oldest first.
+type History struct {
+	path string
+	Runs []Run
+}
+
+// LoadHistory reads the history at path. A missing file is an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &h.Runs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Baseline returns the run to compare run against: the latest one labelled
+// label, or when label is empty the latest one measuring different code.
+func (h *History) Baseline(run Run, label string) (Run, bool) {
+	for i := len(h.Runs) - 1; i >= 0; i-- {
+		r := h.Runs[i]
+		if label != "" && r.Label() == label || label == "" && r.Label() != run.Label() {
+			return r, true
+		}
+	}
+	return Run{}, false
+}
+
+// Record adds run to the history, replacing any earlier run of the same code,
+// and saves it. Results for solutions run didn't measure are carried over from
+// the replaced run.
+func (h *History) Record(run Run) error {
+	runs := h.Runs[:0]
+	for _, r := range h.Runs {
+		if r.Label() != run.Label() {
+			runs = append(runs, r)
+			continue
+		}
+		for name, result := range r.Results {
+			if _, ok := run.Results[name]; !ok {
+				run.Results[name] = result
+			}
+		}
+	}
+	h.Runs = append(runs, run)
+
+	b, err := json.MarshalIndent(h.Runs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, append(b, '\n'), 0o644)
+}
+
+// Change compares a solution's result in a run against a baseline.
+type Change struct {
+	Name string
+	Old  Result
+	New  Result
+	// HasOld is set when the baseline measured the solution on the same input.
+	HasOld bool
+}
+
+// Compare pairs every result in run with the baseline's result for the same
+// solution, in the order of names.
+func Compare(names []string, baseline, run Run) []Change {
+	var changes []Change
+	for _, name := range names {
+		result, ok := run.Results[name]
+		if !ok {
+			continue
+		}
+		old, ok := baseline.Results[name]
+		changes = append(changes, Change{
+			Name:   name,
+			Old:    old,
+			New:    result,
+			HasOld: ok && old.Input == result.Input,
+		})
+	}
+	return changes
+}
+
+// Delta is the relative change from old to new, 0.1 meaning 10% more.
+func Delta(old, new int64) float64 {
+	if old == 0 {
+		if new == 0 {
+			return 0
+		}
+		return 1
+	}
+	return float64(new-old) / float64(old)
+}
+
+// Regressed reports whether the time, allocations or bytes per call grew by
+// more than threshold.
+func (c Change) Regressed(threshold float64) bool {
+	if !c.HasOld {
+		return false
+	}
+	return Delta(c.Old.NsPerOp, c.New.NsPerOp) > threshold ||
+		Delta(c.Old.AllocsPerOp, c.New.AllocsPerOp) > threshold ||
+		Delta(c.Old.BytesPerOp, c.New.BytesPerOp) > threshold
+}
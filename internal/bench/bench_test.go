@@ -0,0 +1,86 @@
+package bench
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "benchmarks.json")
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := Run{Commit: "aaa", Time: time.Unix(1, 0).UTC(), Results: map[string]Result{
+		"day 05 part 1": {Input: "input", NsPerOp: 100},
+		"day 05 part 2": {Input: "input", NsPerOp: 200},
+	}}
+	if err := h.Record(first); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := h.Baseline(first, ""); ok {
+		t.Error("Baseline() of the only run found one")
+	}
+
+	// Re-running only part 2 at the same commit replaces that result and
+	// keeps part 1's.
+	again := Run{Commit: "aaa", Time: time.Unix(2, 0).UTC(), Results: map[string]Result{
+		"day 05 part 2": {Input: "input", NsPerOp: 150},
+	}}
+	if err := h.Record(again); err != nil {
+		t.Fatal(err)
+	}
+
+	h, err = LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Runs) != 1 {
+		t.Fatalf("history has %d runs, want 1", len(h.Runs))
+	}
+	if got := h.Runs[0].Results; got["day 05 part 1"].NsPerOp != 100 || got["day 05 part 2"].NsPerOp != 150 {
+		t.Errorf("merged results = %v", got)
+	}
+
+	dirty := Run{Commit: "aaa", Dirty: true}
+	base, ok := h.Baseline(dirty, "")
+	if !ok || base.Label() != "aaa" {
+		t.Errorf("Baseline(aaa+dirty) = %s, %v, want aaa", base.Label(), ok)
+	}
+	if _, ok := h.Baseline(dirty, "bbb"); ok {
+		t.Error("Baseline() found a run of a commit never recorded")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := Run{Results: map[string]Result{
+		"slower":   {Input: "input", NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 1000},
+		"allocs":   {Input: "input", NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 1000},
+		"noise":    {Input: "input", NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 1000},
+		"newInput": {Input: "example.txt", NsPerOp: 1, AllocsPerOp: 1, BytesPerOp: 1},
+	}}
+	run := Run{Results: map[string]Result{
+		"slower":   {Input: "input", NsPerOp: 150, AllocsPerOp: 10, BytesPerOp: 1000},
+		"allocs":   {Input: "input", NsPerOp: 90, AllocsPerOp: 20, BytesPerOp: 1000},
+		"noise":    {Input: "input", NsPerOp: 105, AllocsPerOp: 10, BytesPerOp: 1000},
+		"newInput": {Input: "input", NsPerOp: 100, AllocsPerOp: 10, BytesPerOp: 1000},
+		"new":      {Input: "input", NsPerOp: 100},
+	}}
+
+	want := map[string]bool{"slower": true, "allocs": true, "noise": false, "newInput": false, "new": false}
+	names := []string{"slower", "allocs", "noise", "newInput", "new", "missing"}
+	changes := Compare(names, baseline, run)
+	if len(changes) != len(want) {
+		t.Fatalf("Compare() returned %d changes, want %d", len(changes), len(want))
+	}
+	for i, c := range changes {
+		if c.Name != names[i] {
+			t.Errorf("change %d is %s, want %s", i, c.Name, names[i])
+		}
+		if got := c.Regressed(0.1); got != want[c.Name] {
+			t.Errorf("%s: Regressed() = %v, want %v", c.Name, got, want[c.Name])
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Package client talks to the Advent of Code website, keeping a per-user cache
+// of puzzle inputs so each one is only ever downloaded once.
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	DefaultYear    = 2023
+
+	// SessionEnv holds the session cookie value, which overrides the one in
+	// the config file.
+	SessionEnv = "AOC_SESSION"
+	// BaseURLEnv overrides DefaultBaseURL.
+	BaseURLEnv = "AOC_BASE_URL"
+
+	userAgent = "github.com/HugoKlepsch/AoC2023"
+)
+
+// ErrNoSession is returned when no session token is configured.
+var ErrNoSession = errors.New("no session token: set " + SessionEnv + " or write it to the config file")
+
+type Client struct {
+	// BaseURL is the site to talk to, without a trailing slash.
+	BaseURL string
+	Year    int
+	// Session is the value of the site's session cookie, which identifies the
+	// account whose inputs are fetched.
+	Session string
+	// CacheDir is where inputs are kept, under a directory per account.
+	CacheDir   string
+	HTTPClient *http.Client
+}
+
+// New returns a client for the account owning session, talking to the site at
+// BaseURLEnv or DefaultBaseURL and caching under the user's cache directory.
+func New(session string) (*Client, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	baseURL := os.Getenv(BaseURLEnv)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		Year:       DefaultYear,
+		Session:    session,
+		CacheDir:   filepath.Join(cacheDir, "aoc"),
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// ConfigPath is the file LoadSession reads the session token from.
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
+
+// LoadSession returns the session token from SessionEnv, or failing that from
+// the file at ConfigPath.
+func LoadSession() (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnv)); session != "" {
+		return session, nil
+	}
+	path, err := ConfigPath()
+	if err != nil {
+		return "", err
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", ErrNoSession
+	} else if err != nil {
+		return "", err
+	}
+	session := strings.TrimSpace(string(b))
+	if session == "" {
+		return "", ErrNoSession
+	}
+	return session, nil
+}
+
+// account names the account owning the session in the cache without writing
+// the session token itself to disk.
+func (c *Client) account() string {
+	sum := sha256.Sum256([]byte(c.Session))
+	return hex.EncodeToString(sum[:8])
+}
+
+// InputPath is where the input for day is cached.
+func (c *Client) InputPath(day int) string {
+	return filepath.Join(c.CacheDir, c.account(), fmt.Sprint(c.Year), fmt.Sprintf("day-%02d", day), "input")
+}
+
+// Input returns the puzzle input for day, from the cache when it is there and
+// from the site, filling the cache, when it isn't.
+func (c *Client) Input(day int) ([]byte, error) {
+	path := c.InputPath(day)
+	input, err := os.ReadFile(path)
+	if err == nil {
+		return input, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	input, err = c.fetchInput(day)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(path, input); err != nil {
+		return nil, fmt.Errorf("caching input: %w", err)
+	}
+	return input, nil
+}
+
+func (c *Client) fetchInput(day int) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	resp, err := c.get(fmt.Sprintf("/%d/day/%d/input", c.Year, day))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching day %d input: %s: %s", day, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+func (c *Client) get(path string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	return c.HTTPClient.Do(req)
+}
+
+// writeFileAtomic writes data to path, creating its directory, such that path
+// never holds a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a client talking to a fake site serving handler, with
+// a fresh cache directory.
+func newTestClient(t *testing.T, session string, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Client{
+		BaseURL:    server.URL,
+		Year:       DefaultYear,
+		Session:    session,
+		CacheDir:   t.TempDir(),
+		HTTPClient: server.Client(),
+	}
+}
+
+func TestInputFetchesOnceAndCaches(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, "secret", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2023/day/7/input" {
+			t.Errorf("path = %s, want /2023/day/7/input", r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v", cookie, err)
+		}
+		w.Write([]byte("32T3K 765\n"))
+	})
+
+	for i := 0; i < 3; i++ {
+		input, err := c.Input(7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(input) != "32T3K 765\n" {
+			t.Errorf("Input(7) = %q", input)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+
+	cached, err := os.ReadFile(c.InputPath(7))
+	if err != nil || string(cached) != "32T3K 765\n" {
+		t.Errorf("cache file holds %q, %v", cached, err)
+	}
+}
+
+func TestInputCacheIsPerAccount(t *testing.T) {
+	c := newTestClient(t, "alice", func(w http.ResponseWriter, r *http.Request) {
+		cookie, _ := r.Cookie("session")
+		w.Write([]byte(cookie.Value))
+	})
+
+	alice, err := c.Input(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Session = "bob"
+	bob, err := c.Input(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(alice) != "alice" || string(bob) != "bob" {
+		t.Errorf("got %q and %q, want each account's own input", alice, bob)
+	}
+	if strings.Contains(c.InputPath(1), "bob") {
+		t.Errorf("cache path %s contains the session token", c.InputPath(1))
+	}
+}
+
+func TestInputErrorsAreNotCached(t *testing.T) {
+	fail := true
+	c := newTestClient(t, "secret", func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("input"))
+	})
+
+	if _, err := c.Input(25); err == nil {
+		t.Fatal("Input(25) succeeded on a 404")
+	}
+	fail = false
+	if input, err := c.Input(25); err != nil || string(input) != "input" {
+		t.Errorf("Input(25) = %q, %v after the puzzle unlocked", input, err)
+	}
+}
+
+func TestInputNeedsSession(t *testing.T) {
+	c := newTestClient(t, "", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("made a request without a session")
+	})
+	if _, err := c.Input(1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("err = %v, want ErrNoSession", err)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	t.Setenv(SessionEnv, "")
+	if _, err := LoadSession(); !errors.Is(err, ErrNoSession) {
+		t.Errorf("with nothing configured, err = %v, want ErrNoSession", err)
+	}
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if session, err := LoadSession(); err != nil || session != "from-file" {
+		t.Errorf("LoadSession() = %q, %v, want from-file", session, err)
+	}
+
+	t.Setenv(SessionEnv, "from-env")
+	if session, err := LoadSession(); err != nil || session != "from-env" {
+		t.Errorf("LoadSession() = %q, %v, want from-env", session, err)
+	}
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrRefused is wrapped by the errors History.Check returns for answers that
+// shouldn't be submitted.
+var ErrRefused = errors.New("refusing to submit")
+
+// Submission is one answer sent to the site and its verdict.
+type Submission struct {
+	Day     int           `json:"day"`
+	Part    int           `json:"part"`
+	Answer  string        `json:"answer"`
+	Time    time.Time     `json:"time"`
+	Outcome Outcome       `json:"outcome"`
+	Wait    time.Duration `json:"wait,omitempty"`
+	Message string        `json:"message,omitempty"`
+}
+
+// History is every answer submitted for one account and year, kept in a JSON
+// file.
+type History struct {
+	path        string
+	Submissions []Submission
+}
+
+// HistoryPath is where the submission history of the client's account is kept.
+func (c *Client) HistoryPath() string {
+	return filepath.Join(c.CacheDir, c.account(), fmt.Sprint(c.Year), "submissions.json")
+}
+
+// LoadHistory reads the history at path. A missing file is an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &h.Submissions); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Record adds sub to the history and saves it.
+func (h *History) Record(sub Submission) error {
+	h.Submissions = append(h.Submissions, sub)
+	b, err := json.MarshalIndent(h.Submissions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(h.path, append(b, '\n'))
+}
+
+// Check returns an error wrapping ErrRefused when the history shows answer is
+// pointless to submit at now: the part is already solved, the answer was
+// already rejected or is outside a bound an earlier answer established, or the
+// site asked us to wait and that time isn't up.
+func (h *History) Check(day, part int, answer string, now time.Time) error {
+	candidate, numeric := new(big.Int).SetString(answer, 10)
+
+	for _, s := range h.Submissions {
+		if s.Wait > 0 {
+			if until := s.Time.Add(s.Wait); until.After(now) {
+				return fmt.Errorf("%w: the site asked us to wait until %s", ErrRefused, until.Format(time.TimeOnly))
+			}
+		}
+		if s.Day != day || s.Part != part {
+			continue
+		}
+
+		switch s.Outcome {
+		case OutcomeCorrect:
+			return fmt.Errorf("%w: day %d part %d was already solved with %s", ErrRefused, day, part, s.Answer)
+		case OutcomeAlreadySolved:
+			return fmt.Errorf("%w: day %d part %d was already solved", ErrRefused, day, part)
+		}
+		if !s.Outcome.Rejected() {
+			continue
+		}
+		if s.Answer == answer {
+			return fmt.Errorf("%w: %s was already rejected as %s at %s", ErrRefused, answer, s.Outcome, s.Time.Format(time.DateTime))
+		}
+
+		bound, ok := new(big.Int).SetString(s.Answer, 10)
+		if !numeric || !ok {
+			continue
+		}
+		if s.Outcome == OutcomeTooHigh && candidate.Cmp(bound) >= 0 {
+			return fmt.Errorf("%w: %s is not below %s, which was too high", ErrRefused, answer, s.Answer)
+		}
+		if s.Outcome == OutcomeTooLow && candidate.Cmp(bound) <= 0 {
+			return fmt.Errorf("%w: %s is not above %s, which was too low", ErrRefused, answer, s.Answer)
+		}
+	}
+	return nil
+}
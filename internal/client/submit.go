@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's verdict on a submitted answer.
+type Outcome string
+
+const (
+	OutcomeCorrect Outcome = "correct"
+	OutcomeWrong   Outcome = "wrong"
+	OutcomeTooHigh Outcome = "too high"
+	OutcomeTooLow  Outcome = "too low"
+	// OutcomeWait means the answer wasn't looked at because the last one was
+	// submitted too recently.
+	OutcomeWait Outcome = "wait"
+	// OutcomeAlreadySolved means the part had already been solved, so the
+	// answer wasn't looked at.
+	OutcomeAlreadySolved Outcome = "already solved"
+)
+
+// Rejected reports whether the site looked at the answer and found it wrong.
+func (o Outcome) Rejected() bool {
+	return o == OutcomeWrong || o == OutcomeTooHigh || o == OutcomeTooLow
+}
+
+var (
+	articleRegex  = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRegex      = regexp.MustCompile(`<[^>]*>`)
+	leftRegex     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	tryAgainRegex = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResponse reads the site's verdict on an answer from the page returned
+// for it, along with how long it asked us to wait before the next answer.
+func ParseResponse(page string) (Outcome, time.Duration, string, error) {
+	message := page
+	if m := articleRegex.FindStringSubmatch(page); m != nil {
+		message = m[1]
+	}
+	message = strings.Join(strings.Fields(tagRegex.ReplaceAllString(message, "")), " ")
+
+	var wait time.Duration
+	if m := leftRegex.FindStringSubmatch(message); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := tryAgainRegex.FindStringSubmatch(message); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		wait = time.Duration(minutes) * time.Minute
+	}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		return OutcomeCorrect, wait, message, nil
+	case strings.Contains(message, "That's not the right answer") && strings.Contains(message, "too high"):
+		return OutcomeTooHigh, wait, message, nil
+	case strings.Contains(message, "That's not the right answer") && strings.Contains(message, "too low"):
+		return OutcomeTooLow, wait, message, nil
+	case strings.Contains(message, "That's not the right answer"):
+		return OutcomeWrong, wait, message, nil
+	case strings.Contains(message, "You gave an answer too recently"):
+		return OutcomeWait, wait, message, nil
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		return OutcomeAlreadySolved, wait, message, nil
+	}
+	return "", 0, message, fmt.Errorf("unrecognised response: %q", message)
+}
+
+// Submit posts answer for the given day and part, returning the site's
+// verdict. It does not consult or record history; see History for that.
+func (c *Client) Submit(day, part int, answer string) (Submission, error) {
+	sub := Submission{Day: day, Part: part, Answer: answer, Time: time.Now()}
+	if c.Session == "" {
+		return sub, ErrNoSession
+	}
+
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, c.Year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return sub, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.do(req)
+	if err != nil {
+		return sub, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return sub, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return sub, fmt.Errorf("submitting day %d part %d: %s: %s", day, part, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	sub.Outcome, sub.Wait, sub.Message, err = ParseResponse(string(body))
+	return sub, err
+}
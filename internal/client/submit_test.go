@@ -0,0 +1,151 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func page(article string) string {
+	return `<!DOCTYPE html><html><body><main><article><p>` + article + `</p></article></main></body></html>`
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    string
+		outcome Outcome
+		wait    time.Duration
+	}{
+		{
+			name:    "correct",
+			page:    page(`That's the right answer! You are <span class="day-success">one gold star</span> closer to restoring snow operations.`),
+			outcome: OutcomeCorrect,
+		},
+		{
+			name:    "too high",
+			page:    page(`That's not the right answer; your answer is too high. If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2023/day/7">[Return to Day 7]</a>`),
+			outcome: OutcomeTooHigh,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too low",
+			page:    page(`That's not the right answer; your answer is too low. Please wait 5 minutes before trying again.`),
+			outcome: OutcomeTooLow,
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "wrong",
+			page:    page(`That's not the right answer. If you're stuck, make sure you're using the full input data.`),
+			outcome: OutcomeWrong,
+		},
+		{
+			name:    "too recently",
+			page:    page(`You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 1m 23s left to wait. <a href="/2023/day/7">[Return to Day 7]</a>`),
+			outcome: OutcomeWait,
+			wait:    83 * time.Second,
+		},
+		{
+			name:    "already solved",
+			page:    page(`You don't seem to be solving the right level. Did you already complete it? <a href="/2023/day/7">[Return to Day 7]</a>`),
+			outcome: OutcomeAlreadySolved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome, wait, _, err := ParseResponse(tt.page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if outcome != tt.outcome || wait != tt.wait {
+				t.Errorf("ParseResponse() = %s, %s, want %s, %s", outcome, wait, tt.outcome, tt.wait)
+			}
+		})
+	}
+
+	if _, _, _, err := ParseResponse(page("Something else entirely")); err == nil {
+		t.Error("ParseResponse() of an unknown page succeeded")
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	c := newTestClient(t, "secret", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2023/day/7/answer" {
+			t.Errorf("request = %s %s, want POST /2023/day/7/answer", r.Method, r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v", cookie, err)
+		}
+		if level, answer := r.FormValue("level"), r.FormValue("answer"); level != "2" || answer != "5905" {
+			t.Errorf("form = level %q answer %q, want level 2 answer 5905", level, answer)
+		}
+		w.Write([]byte(page(`That's the right answer!`)))
+	})
+
+	sub, err := c.Submit(7, 2, "5905")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Outcome != OutcomeCorrect || sub.Day != 7 || sub.Part != 2 || sub.Answer != "5905" {
+		t.Errorf("Submit() = %+v", sub)
+	}
+}
+
+func TestHistoryCheck(t *testing.T) {
+	start := time.Date(2023, 12, 7, 6, 0, 0, 0, time.UTC)
+	h := &History{Submissions: []Submission{
+		{Day: 7, Part: 1, Answer: "1000", Time: start, Outcome: OutcomeTooHigh, Wait: time.Minute},
+		{Day: 7, Part: 1, Answer: "100", Time: start.Add(2 * time.Minute), Outcome: OutcomeTooLow, Wait: time.Minute},
+		{Day: 7, Part: 1, Answer: "500", Time: start.Add(4 * time.Minute), Outcome: OutcomeWrong, Wait: time.Minute},
+		{Day: 6, Part: 1, Answer: "288", Time: start.Add(-time.Hour), Outcome: OutcomeCorrect},
+	}}
+	later := start.Add(time.Hour)
+
+	tests := []struct {
+		day, part int
+		answer    string
+		now       time.Time
+		refused   bool
+	}{
+		{7, 1, "400", later, false},
+		{7, 1, "500", later, true},
+		{7, 1, "1000", later, true},
+		{7, 1, "2000", later, true},
+		{7, 1, "100", later, true},
+		{7, 1, "-5", later, true},
+		{7, 1, "999", later, false},
+		{7, 1, "not a number", later, false},
+		{7, 2, "2000", later, false},
+		{7, 1, "400", start.Add(4*time.Minute + 30*time.Second), true},
+		{6, 1, "288", later, true},
+		{6, 1, "289", later, true},
+	}
+	for _, tt := range tests {
+		err := h.Check(tt.day, tt.part, tt.answer, tt.now)
+		if refused := errors.Is(err, ErrRefused); refused != tt.refused {
+			t.Errorf("Check(%d, %d, %q) = %v, want refused %v", tt.day, tt.part, tt.answer, err, tt.refused)
+		}
+	}
+}
+
+func TestHistoryRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "account", "2023", "submissions.json")
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := Submission{Day: 7, Part: 2, Answer: "42", Time: time.Now().UTC().Round(time.Second), Outcome: OutcomeTooLow, Wait: time.Minute}
+	if err := h.Record(sub); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.Submissions) != 1 || reloaded.Submissions[0] != sub {
+		t.Errorf("reloaded history = %+v, want [%+v]", reloaded.Submissions, sub)
+	}
+}
@@ -0,0 +1,43 @@
+package p1
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	total := 0
+	lineNo := 0
+
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		digits := []int{}
+		for i := 0; i < len(line); i++ {
+			c := line[i]
+			if c >= '0' && c <= '9' {
+				digits = append(digits, int(c-48))
+			}
+		}
+		aoc.Debugf("Digits: %v\n", digits)
+		if len(digits) == 0 {
+			return "", &aoc.ParseError{Line: lineNo, Text: line, Expected: "at least one digit"}
+		}
+		code := 10*digits[0] + digits[len(digits)-1]
+		total += code
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Sum: %d\n", total)
+	return strconv.Itoa(total), nil
+}
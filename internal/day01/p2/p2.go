@@ -0,0 +1,79 @@
+package p2
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+var regexes = map[*regexp.Regexp]int{
+	regexp.MustCompile(`^one`):   1,
+	regexp.MustCompile(`^two`):   2,
+	regexp.MustCompile(`^three`): 3,
+	regexp.MustCompile(`^four`):  4,
+	regexp.MustCompile(`^five`):  5,
+	regexp.MustCompile(`^six`):   6,
+	regexp.MustCompile(`^seven`): 7,
+	regexp.MustCompile(`^eight`): 8,
+	regexp.MustCompile(`^nine`):  9,
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	total := 0
+	lineNo := 0
+
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		digits := []int{}
+
+		for {
+			if len(line) == 0 {
+				break
+			}
+			matched := false
+			for r, dig := range regexes {
+				if r.MatchString(line) {
+					line = line[1:]
+
+					digits = append(digits, dig)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				c := line[0]
+				if c >= '0' && c <= '9' {
+					digits = append(digits, int(c-48))
+					line = line[1:]
+					matched = true
+				}
+			}
+			if !matched {
+				line = line[1:]
+			}
+		}
+
+		aoc.Debugf("Digits: %v\n", digits)
+		if len(digits) == 0 {
+			return "", &aoc.ParseError{Line: lineNo, Text: fileScanner.Text(), Expected: "at least one digit"}
+		}
+		code := 10*digits[0] + digits[len(digits)-1]
+		aoc.Debugf("Code: %d\n", code)
+		total += code
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Sum: %d\n", total)
+	return strconv.Itoa(total), nil
+}
@@ -0,0 +1,110 @@
+package p2
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type Cube int
+
+const (
+	ColorRed Cube = iota
+	ColorGreen
+	ColorBlue
+)
+
+type Game struct {
+	ID     int
+	Rounds []Round
+}
+
+type Round struct {
+	CubeCount map[Cube]int
+}
+
+var cubeColors = map[string]Cube{
+	"red":   ColorRed,
+	"green": ColorGreen,
+	"blue":  ColorBlue,
+}
+
+// parseGame parses one line of the game record.
+func parseGame(line string) (Game, error) {
+	game := Game{}
+	var rounds parse.Field
+	if err := parse.Scanf(line, "Game {int}: {rest}", &game.ID, &rounds); err != nil {
+		return game, err
+	}
+	for _, shownRound := range rounds.Split(";") {
+		round := Round{CubeCount: map[Cube]int{
+			ColorRed:   0,
+			ColorGreen: 0,
+			ColorBlue:  0,
+		}}
+		for _, shown := range shownRound.Split(",") {
+			var count int
+			var color parse.Field
+			if err := shown.Scanf("{int} {word}", &count, &color); err != nil {
+				return game, err
+			}
+			cube, ok := cubeColors[color.Text]
+			if !ok {
+				return game, color.Expected("red, green or blue", nil)
+			}
+			round.CubeCount[cube] += count
+		}
+		game.Rounds = append(game.Rounds, round)
+	}
+	return game, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	score := 0
+	lineNo := 0
+
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		game, err := parseGame(line)
+		if err != nil {
+			return "", parse.AtLine(err, lineNo, line)
+		}
+		gameMin := map[Cube]int{
+			ColorRed:   0,
+			ColorGreen: 0,
+			ColorBlue:  0,
+		}
+
+		for _, round := range game.Rounds {
+			for cube, curMin := range gameMin {
+				if round.CubeCount[cube] > curMin {
+					gameMin[cube] = round.CubeCount[cube]
+				}
+			}
+		}
+
+		power := 1
+		for _, curMin := range gameMin {
+			power *= curMin
+		}
+		score += power
+
+		aoc.Debugf("game: %v\n", game)
+	}
+
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
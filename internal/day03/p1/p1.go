@@ -0,0 +1,96 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func isNumber(c uint8) bool {
+	return c >= '0' && c <= '9'
+}
+
+func getChar(schematic *grid.Grid[byte], x int, y int) uint8 {
+	return schematic.GetOr(grid.Vector{X: x, Y: y}, '.')
+}
+
+func isSymbol(c uint8) bool {
+	if isNumber(c) {
+		return false
+	}
+	return c != '.' && c != '\n'
+}
+
+// parseNumber will return the number starting at x, y, and its length.
+func parseNumber(schematic *grid.Grid[byte], x, y int) (int, int, error) {
+	var (
+		start = x
+		end   = x
+	)
+	line := schematic.Row(y)
+	for i := start; i < len(line); i++ {
+		c := line[i]
+		if !isNumber(c) {
+			break
+		}
+		end = i
+	}
+	numStr := string(line[start : end+1])
+	num, err := strconv.Atoi(numStr)
+	if err != nil {
+		return 0, 0, &aoc.ParseError{Line: y + 1, Column: start + 1, Text: string(line), Expected: "part number", Err: err}
+	}
+	return num, end + 1 - start, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	schematic, err := grid.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := 0
+	symbols := map[uint8]struct{}{}
+
+	for y := 0; y < schematic.Height(); y++ {
+		for x := 0; x < schematic.Width(); x++ {
+			c := getChar(schematic, x, y)
+			if isNumber(c) {
+				// Number start!
+				number, length, err := parseNumber(schematic, x, y)
+				if err != nil {
+					return "", err
+				}
+				hasSymbol := false
+				// Is there a symbol touching this number on the previous line?
+				for yi := y - 1; yi <= y+1 && !hasSymbol; yi++ {
+					for xi := x - 1; xi <= x+length && !hasSymbol; xi++ {
+						ci := getChar(schematic, xi, yi)
+						if isSymbol(ci) {
+							aoc.Debugf("Found symbol for %d (%d, %d): %c (%d, %d)\n", number, x, y, ci, xi, yi)
+							symbols[ci] = struct{}{}
+							hasSymbol = true
+							break
+						}
+					}
+				}
+				if hasSymbol {
+					score += number
+				} else {
+					aoc.Debugf("Did not find symbol for %d (%d, %d)\n", number, x, y)
+				}
+				x += length - 1
+			}
+		}
+	}
+
+	aoc.Debugf("Symbols: \n")
+	for c, _ := range symbols {
+		aoc.Debugf("'%c'\n", c)
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
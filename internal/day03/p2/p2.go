@@ -0,0 +1,113 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func isNumber(c uint8) bool {
+	return c >= '0' && c <= '9'
+}
+
+func getChar(schematic *grid.Grid[byte], x int, y int) uint8 {
+	return schematic.GetOr(grid.Vector{X: x, Y: y}, '.')
+}
+
+func isSymbol(c uint8) bool {
+	if isNumber(c) {
+		return false
+	}
+	return c != '.' && c != '\n'
+}
+
+type StringPosition struct {
+	x, y, length int
+}
+
+// parseNumber will return the number containing x, y, startX, and its length.
+func parseNumber(schematic *grid.Grid[byte], x, y int) (int, int, int, error) {
+	var (
+		start = x
+		end   = x
+	)
+	line := schematic.Row(y)
+	// find start
+	for i := start; i >= 0; i-- {
+		c := line[i]
+		if !isNumber(c) {
+			break
+		}
+		start = i
+	}
+	// find end
+	for i := start; i < len(line); i++ {
+		c := line[i]
+		if !isNumber(c) {
+			break
+		}
+		end = i
+	}
+	numStr := string(line[start : end+1])
+	num, err := strconv.Atoi(numStr)
+	if err != nil {
+		return 0, 0, 0, &aoc.ParseError{Line: y + 1, Column: start + 1, Text: string(line), Expected: "part number", Err: err}
+	}
+	return num, start, end + 1 - start, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	schematic, err := grid.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := 0
+
+	for y := 0; y < schematic.Height(); y++ {
+		for x := 0; x < schematic.Width(); x++ {
+			c := getChar(schematic, x, y)
+			if c == '*' {
+				adjacentParts := map[StringPosition]int{} // value is part number
+
+				for yi := y - 1; yi <= y+1; yi++ {
+					for xi := x - 1; xi <= x+1; xi++ {
+						ci := getChar(schematic, xi, yi)
+						if isNumber(ci) {
+							number, start, length, err := parseNumber(schematic, xi, yi)
+							if err != nil {
+								return "", err
+							}
+							aoc.Debugf("Found adjacent number for %c (%d, %d): %d[%d] (%d, %d)\n", c, x, y, number, length, xi, yi)
+							pos := StringPosition{
+								x:      start,
+								y:      yi,
+								length: length,
+							}
+							adjacentParts[pos] = number
+						}
+					}
+				}
+
+				aoc.Debugf("Adjacent numbers for %c (%d, %d):\n", c, x, y)
+				for pos, number := range adjacentParts {
+					aoc.Debugf("\t%d[%d] (%d, %d)\n", number, pos.length, pos.x, pos.y)
+				}
+				aoc.Debugf("---------")
+				gearRatio := 1
+				if len(adjacentParts) == 2 {
+					for _, number := range adjacentParts {
+						gearRatio *= number
+					}
+					score += gearRatio
+				}
+			}
+		}
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
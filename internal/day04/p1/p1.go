@@ -0,0 +1,59 @@
+package p1
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// toSet returns the distinct numbers in nums.
+func toSet(nums []int) map[int]struct{} {
+	set := map[int]struct{}{}
+	for _, num := range nums {
+		set[num] = struct{}{}
+	}
+	return set
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	score := 0
+	lineNo := 0
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		var card int
+		var winnerList, haverList []int
+		if err := parse.Scanf(line, "Card {int}: {ints} | {ints}", &card, &winnerList, &haverList); err != nil {
+			return "", parse.AtLine(err, lineNo, line)
+		}
+		winners, havers := toSet(winnerList), toSet(haverList)
+
+		cardScore := 0
+		for have := range havers {
+			if _, ok := winners[have]; ok {
+				aoc.Debugf("winner: %d\n", have)
+				if cardScore == 0 {
+					cardScore = 1
+				} else {
+					cardScore = cardScore << 1
+				}
+			}
+		}
+		score += cardScore
+		aoc.Debugf("-----------\n")
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,85 @@
+package p2
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// toSet returns the distinct numbers in nums.
+func toSet(nums []int) map[int]struct{} {
+	set := map[int]struct{}{}
+	for _, num := range nums {
+		set[num] = struct{}{}
+	}
+	return set
+}
+
+type DefaultOneMap map[int]int
+
+func (m DefaultOneMap) Get(i int) int {
+	if val, ok := m[i]; ok {
+		return val
+	} else {
+		m[i] = 1
+		return m[i]
+	}
+}
+
+func (m *DefaultOneMap) Inc(i int) {
+	val := m.Get(i)
+	(*m)[i] = val + 1
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	var copyCounts DefaultOneMap = map[int]int{}
+
+	score := 0
+	lineI := 0
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		var card int
+		var winnerList, haverList []int
+		if err := parse.Scanf(line, "Card {int}: {ints} | {ints}", &card, &winnerList, &haverList); err != nil {
+			return "", parse.AtLine(err, lineI+1, line)
+		}
+		winners, havers := toSet(winnerList), toSet(haverList)
+
+		winnerCount := 0
+		for have := range havers {
+			if _, ok := winners[have]; ok {
+				winnerCount++
+			}
+		}
+
+		copyCount := copyCounts.Get(lineI)
+		// Run scoring for this card N times, where N is the number of copies of this card we have
+		for i := 0; i < copyCount; i++ {
+
+			// When we score this card, we add additional copies of later cards
+			for winnerI := lineI + 1; winnerI < (winnerCount + lineI + 1); winnerI++ {
+				copyCounts.Inc(winnerI)
+			}
+		}
+		lineI++
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	for cardI, copies := range copyCounts {
+		aoc.Debugf("Card %d had %d total copies\n", cardI, copies)
+		score += copies // Score one for every instance of the card
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,110 @@
+package p1
+
+import (
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type MapRule struct {
+	start, end, diff int64
+}
+
+func NewMapRule(dst, src, len int64) MapRule {
+	return MapRule{
+		start: src,
+		end:   src + len,
+		diff:  dst - src,
+	}
+}
+
+func (r MapRule) Map(in int64) (int64, bool) {
+
+	if in >= r.start && in < r.end {
+		return in + r.diff, true
+	}
+	return 0, false
+}
+
+type Mapper struct {
+	ruleSet []MapRule
+}
+
+func (m *Mapper) Map(in int64) int64 {
+	for _, rule := range m.ruleSet {
+		if val, ok := rule.Map(in); ok {
+			return val
+		}
+	}
+	return in
+}
+
+// parseMappers parses the maps following the seeds, each of which is a stage
+// the seeds pass through in turn.
+func parseMappers(paragraphs []parse.Paragraph) ([]*Mapper, error) {
+	mappers := []*Mapper{}
+	for _, p := range paragraphs {
+		var name string
+		if err := parse.Scanf(p.Lines[0], "{word} map:", &name); err != nil {
+			return nil, parse.AtLine(err, p.Line, p.Lines[0])
+		}
+		mapper := &Mapper{}
+		for i, line := range p.Lines[1:] {
+			var dst, src, length int
+			if err := parse.Scanf(line, "{int} {int} {int}", &dst, &src, &length); err != nil {
+				return nil, parse.AtLine(err, p.Line+1+i, line)
+			}
+			mapper.ruleSet = append(mapper.ruleSet, NewMapRule(int64(dst), int64(src), int64(length)))
+		}
+		aoc.Debugf("%s: %d rules\n", name, len(mapper.ruleSet))
+		mappers = append(mappers, mapper)
+	}
+	return mappers, nil
+}
+
+// parseSeeds parses the paragraph listing the seeds.
+func parseSeeds(p parse.Paragraph) ([]int, error) {
+	var seeds []int
+	if err := parse.Scanf(p.Lines[0], "seeds: {ints}", &seeds); err != nil {
+		return nil, parse.AtLine(err, p.Line, p.Lines[0])
+	}
+	if len(p.Lines) > 1 {
+		return nil, &aoc.ParseError{Line: p.Line + 1, Text: p.Lines[1], Expected: "a blank line after the seeds"}
+	}
+	return seeds, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	paragraphs, err := parse.Paragraphs(r)
+	if err != nil {
+		return "", err
+	}
+	if len(paragraphs) == 0 {
+		return "", &aoc.ParseError{Line: 1, Expected: `"seeds: <numbers>"`}
+	}
+	seeds, err := parseSeeds(paragraphs[0])
+	if err != nil {
+		return "", err
+	}
+	mappers, err := parseMappers(paragraphs[1:])
+	if err != nil {
+		return "", err
+	}
+
+	lowestLocation := int64(math.MaxInt64)
+	for _, seed := range seeds {
+		x := int64(seed)
+		for _, mapper := range mappers {
+			x = mapper.Map(x)
+		}
+		if x < lowestLocation {
+			lowestLocation = x
+		}
+	}
+	aoc.Debugf("Lowest: %d\n", lowestLocation)
+	return strconv.FormatInt(lowestLocation, 10), nil
+}
@@ -0,0 +1,96 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/interval"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// parseMappers parses the maps following the seeds, each of which is a stage
+// the seeds pass through in turn.
+func parseMappers(paragraphs []parse.Paragraph) ([]*interval.OffsetMap[int64], error) {
+	mappers := []*interval.OffsetMap[int64]{}
+	for _, p := range paragraphs {
+		var name string
+		if err := parse.Scanf(p.Lines[0], "{word} map:", &name); err != nil {
+			return nil, parse.AtLine(err, p.Line, p.Lines[0])
+		}
+		mapper := &interval.OffsetMap[int64]{}
+		for i, line := range p.Lines[1:] {
+			var dst, src, length int
+			if err := parse.Scanf(line, "{int} {int} {int}", &dst, &src, &length); err != nil {
+				return nil, parse.AtLine(err, p.Line+1+i, line)
+			}
+			mapper.AddRule(interval.FromLength(int64(src), int64(length)), int64(dst-src))
+		}
+		aoc.Debugf("%s: %d rules\n", name, len(mapper.Rules()))
+		mappers = append(mappers, mapper)
+	}
+	return mappers, nil
+}
+
+// parseSeeds parses the paragraph listing the ranges of seeds.
+func parseSeeds(p parse.Paragraph) (interval.Set[int64], error) {
+	seeds := interval.NewSet[int64]()
+	var seedLine parse.Field
+	if err := parse.Scanf(p.Lines[0], "seeds: {rest}", &seedLine); err != nil {
+		return seeds, parse.AtLine(err, p.Line, p.Lines[0])
+	}
+	if len(p.Lines) > 1 {
+		return seeds, &aoc.ParseError{Line: p.Line + 1, Text: p.Lines[1], Expected: "a blank line after the seeds"}
+	}
+
+	fields := seedLine.Fields()
+	if len(fields)%2 != 0 {
+		return seeds, &aoc.ParseError{Line: p.Line, Text: p.Lines[0], Expected: "pairs of seed range start and length"}
+	}
+	for i := 0; i < len(fields); i += 2 {
+		start, err := fields[i].Int()
+		if err != nil {
+			return seeds, parse.AtLine(err, p.Line, p.Lines[0])
+		}
+		length, err := fields[i+1].Int()
+		if err != nil {
+			return seeds, parse.AtLine(err, p.Line, p.Lines[0])
+		}
+		seeds = seeds.Add(interval.FromLength(int64(start), int64(length)))
+	}
+	return seeds, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	paragraphs, err := parse.Paragraphs(r)
+	if err != nil {
+		return "", err
+	}
+	if len(paragraphs) == 0 {
+		return "", &aoc.ParseError{Line: 1, Expected: `"seeds: <numbers>"`}
+	}
+	seeds, err := parseSeeds(paragraphs[0])
+	if err != nil {
+		return "", err
+	}
+	mappers, err := parseMappers(paragraphs[1:])
+	if err != nil {
+		return "", err
+	}
+
+	// Push whole ranges of seeds through each stage, splitting them wherever a
+	// stage's rules move part of a range and not the rest.
+	for stage, mapper := range mappers {
+		seeds = mapper.MapSet(seeds)
+		aoc.Debugf("Stage %d: %d ranges\n", stage, len(seeds.Intervals()))
+	}
+
+	lowest, ok := seeds.Min()
+	if !ok {
+		return "", &aoc.ParseError{Line: 1, Text: paragraphs[0].Lines[0], Expected: "at least one seed range"}
+	}
+
+	aoc.Debugf("Lowest: %d\n", lowest)
+	return strconv.FormatInt(lowest, 10), nil
+}
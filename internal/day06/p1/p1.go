@@ -0,0 +1,79 @@
+package p1
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type Race struct {
+	time, distance int
+}
+
+// parseRow parses the numbers following label on one line of the race sheet.
+func parseRow(line string, lineNo int, label string) ([]int, error) {
+	var vals []int
+	if err := parse.Scanf(line, label+": {ints}", &vals); err != nil {
+		return nil, parse.AtLine(err, lineNo, line)
+	}
+	return vals, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return "", err
+	}
+	if len(lines) < 2 {
+		return "", &aoc.ParseError{Line: len(lines) + 1, Expected: `"Time:" and "Distance:" lines`}
+	}
+	timeLine, distanceLine := lines[0], lines[1]
+
+	times, err := parseRow(timeLine, 1, "Time")
+	if err != nil {
+		return "", err
+	}
+	distances, err := parseRow(distanceLine, 2, "Distance")
+	if err != nil {
+		return "", err
+	}
+	if len(distances) != len(times) {
+		return "", &aoc.ParseError{Line: 2, Text: distanceLine, Expected: fmt.Sprintf("%d distances, one per race", len(times))}
+	}
+
+	races := []Race{}
+	for i := range times {
+		races = append(races, Race{
+			time:     times[i],
+			distance: distances[i],
+		})
+	}
+
+	score := 1
+
+	// all distances in millimeters
+	// all times in milliseconds
+	// all speeds in millimeters per millisecond
+	a := 1 // mm/ms/ms
+
+	// Iterative solution: Just try all the possibilities in order
+	for raceNum, race := range races {
+		raceSoltions := 0
+		for holdTime := 1; holdTime < race.time; holdTime++ {
+			v1 := a * holdTime
+			timeLeft := race.time - holdTime
+			distance := v1 * timeLeft
+			if distance > race.distance {
+				aoc.Debugf("Found a solution for race %d. HoldTime: %d\n", raceNum+1, holdTime)
+				raceSoltions++
+			}
+		}
+		score *= raceSoltions
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
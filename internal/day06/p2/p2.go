@@ -0,0 +1,73 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type Race struct {
+	time, distance int
+}
+
+// parseRow parses the single, badly kerned number following label on one line
+// of the race sheet.
+func parseRow(line string, lineNo int, label string) (int, error) {
+	var row parse.Field
+	if err := parse.Scanf(line, label+": {rest}", &row); err != nil {
+		return 0, parse.AtLine(err, lineNo, line)
+	}
+	row.Text = strings.Join(strings.Fields(row.Text), "")
+	val, err := row.Int()
+	if err != nil {
+		return 0, parse.AtLine(err, lineNo, line)
+	}
+	return val, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return "", err
+	}
+	if len(lines) < 2 {
+		return "", &aoc.ParseError{Line: len(lines) + 1, Expected: `"Time:" and "Distance:" lines`}
+	}
+	timeLine, distanceLine := lines[0], lines[1]
+
+	time, err := parseRow(timeLine, 1, "Time")
+	if err != nil {
+		return "", err
+	}
+	distance, err := parseRow(distanceLine, 2, "Distance")
+	if err != nil {
+		return "", err
+	}
+
+	race := Race{
+		time:     int(time),
+		distance: int(distance),
+	}
+
+	// all distances in millimeters
+	// all times in milliseconds
+	// all speeds in millimeters per millisecond
+	a := 1 // mm/ms/ms
+
+	// Iterative solution: Just try all the possibilities in order
+	raceSoltions := 0
+	for holdTime := 1; holdTime < race.time; holdTime++ {
+		v1 := a * holdTime
+		timeLeft := race.time - holdTime
+		distance := v1 * timeLeft
+		if distance > race.distance {
+			raceSoltions++
+		}
+	}
+	aoc.Debugf("Score: %d\n", raceSoltions)
+	return strconv.Itoa(raceSoltions), nil
+}
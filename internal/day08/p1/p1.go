@@ -0,0 +1,88 @@
+package p1
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+type DstTuple struct {
+	L, R string
+}
+
+var lineRegex = regexp.MustCompile(`^([0-9A-Z]+) = \(([0-9A-Z]+), ([0-9A-Z]+)\)`)
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	fileScanner.Scan()
+	directionLine := fileScanner.Text()
+	directions := []uint8{}
+	for i := 0; i < len(directionLine); i++ {
+		if directionLine[i] != 'L' && directionLine[i] != 'R' {
+			return "", &aoc.ParseError{Line: 1, Column: i + 1, Text: directionLine, Expected: "direction (L or R)"}
+		}
+		directions = append(directions, directionLine[i])
+	}
+	if len(directions) == 0 {
+		return "", &aoc.ParseError{Line: 1, Column: 1, Text: directionLine, Expected: "directions"}
+	}
+
+	fwd := map[string]DstTuple{}
+
+	lineNo := 1
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		if line == "" {
+			continue
+		}
+
+		if matches := lineRegex.FindStringSubmatch(line); matches != nil && len(matches) == 4 {
+			src := matches[1]
+			dstl := matches[2]
+			dstr := matches[3]
+			fwd[src] = DstTuple{
+				L: dstl,
+				R: dstr,
+			}
+		} else {
+			return "", &aoc.ParseError{Line: lineNo, Text: line, Expected: `"<node> = (<left>, <right>)"`}
+		}
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	hops := 0
+	start := "AAA"
+	end := "ZZZ"
+	current := start
+	for current != end {
+		for _, direction := range directions {
+			if current == end {
+				break
+			}
+			next, ok := fwd[current]
+			if !ok {
+				return "", fmt.Errorf("could not find mapping for current node %s", current)
+			}
+
+			if direction == 'L' {
+				current = next.L
+			} else if direction == 'R' {
+				current = next.R
+			}
+			hops += 1
+		}
+	}
+	aoc.Debugf("Hops: %d. Score: %d\n", hops, hops/len(directions))
+	return strconv.Itoa(hops), nil
+}
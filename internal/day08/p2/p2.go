@@ -0,0 +1,143 @@
+package p2
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/arith"
+)
+
+type DstTuple struct {
+	L, R string
+}
+
+type Route struct {
+	start, end string
+	length     int
+}
+
+var lineRegex = regexp.MustCompile(`^([0-9A-Z]+) = \(([0-9A-Z]+), ([0-9A-Z]+)\)`)
+
+func In(element string, set map[string]struct{}) bool {
+	_, ok := set[element]
+	return ok
+}
+
+// Traverse traverses the chain until it reaches an end node
+func Traverse(start string, fwd map[string]DstTuple, directionInd int, directions []uint8, ends map[string]struct{}) (Route, error) {
+	hops := 0
+	current := start
+	for ; !In(current, ends); directionInd++ {
+		direction := directions[directionInd%len(directions)]
+		if In(current, ends) {
+			break
+		}
+		next, ok := fwd[current]
+		if !ok {
+			return Route{}, fmt.Errorf("could not find mapping for current node %s", current)
+		}
+
+		if direction == 'L' {
+			current = next.L
+		} else if direction == 'R' {
+			current = next.R
+		}
+		hops += 1
+	}
+	return Route{
+		start:  start,
+		end:    current,
+		length: hops,
+	}, nil
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	fileScanner.Scan()
+	directionLine := fileScanner.Text()
+	directions := []uint8{}
+	for i := 0; i < len(directionLine); i++ {
+		if directionLine[i] != 'L' && directionLine[i] != 'R' {
+			return "", &aoc.ParseError{Line: 1, Column: i + 1, Text: directionLine, Expected: "direction (L or R)"}
+		}
+		directions = append(directions, directionLine[i])
+	}
+	if len(directions) == 0 {
+		return "", &aoc.ParseError{Line: 1, Column: 1, Text: directionLine, Expected: "directions"}
+	}
+
+	fwd := map[string]DstTuple{}
+	starts := map[string]struct{}{}
+	ends := map[string]struct{}{}
+
+	lineNo := 1
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		if line == "" {
+			continue
+		}
+
+		if matches := lineRegex.FindStringSubmatch(line); matches != nil && len(matches) == 4 {
+			src := matches[1]
+			dstl := matches[2]
+			dstr := matches[3]
+			fwd[src] = DstTuple{
+				L: dstl,
+				R: dstr,
+			}
+			if strings.HasSuffix(src, "A") {
+				starts[src] = struct{}{}
+			}
+			if strings.HasSuffix(src, "Z") {
+				ends[src] = struct{}{}
+			}
+		} else {
+			return "", &aoc.ParseError{Line: lineNo, Text: line, Expected: `"<node> = (<left>, <right>)"`}
+		}
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	if len(starts) == 0 {
+		return "", errors.New("no start nodes ending in A")
+	}
+
+	loopLengths := []int{}
+
+	for start := range starts {
+		directionInd := 0
+
+		// Through empirical analysis, I have determined that the first ghost path is the longest, then
+		// further ghost paths are a subset of the first path.
+		// Ex:
+		// 	A -> Z (len 20)
+		// 	Z -> M (len: 1)
+		// 	M -> Z (len: 10)
+		// 	Z -> M (len: 1)
+		// 	M -> Z (len: 10)
+		// We can therefore describe the start->end route as a first length then a recurring loop length.
+		// The ghosts are all at an end together after the least common multiple of the loop lengths.
+		route, err := Traverse(start, fwd, directionInd, directions, ends)
+		if err != nil {
+			return "", err
+		}
+		aoc.Debugf("Route: %s -> %s [%d]\n", route.start, route.end, route.length)
+		loopLengths = append(loopLengths, route.length)
+	}
+	aoc.Debugf("-----\n")
+	score := arith.LCM(loopLengths...)
+	aoc.Debugf("LCM: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,53 @@
+package p2
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/arith"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+func reverse(in []int) {
+	length := len(in)
+	for i := 0; i < length/2; i++ {
+		tmp := in[i]
+		other := length - i - 1
+		in[i] = in[other]
+		in[other] = tmp
+	}
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	var err error
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+
+	score := 0
+	lineNo := 0
+
+	for fileScanner.Scan() {
+		line := fileScanner.Text()
+		lineNo++
+		nums, err := parse.Ints(line)
+		if err != nil {
+			return "", parse.AtLine(err, lineNo, line)
+		}
+		if len(nums) == 0 {
+			return "", &aoc.ParseError{Line: lineNo, Text: line, Expected: "a history of numbers"}
+		}
+		reverse(nums)
+		next := arith.Extrapolate(nums)
+		aoc.Debugf("next: %s %d\n", line, next)
+		score += next
+	}
+	if err = fileScanner.Err(); err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,163 @@
+package p1
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+type ConnectionMap map[grid.Vector]map[uint8]bool
+
+var connectionMap = ConnectionMap{
+	grid.UpVec: {
+		'.': false,
+		'-': false,
+		'7': true,
+		'|': true,
+		'F': true,
+		'J': false,
+		'L': false,
+		'S': true,
+	},
+	grid.RightVec: {
+		'.': false,
+		'-': true,
+		'7': true,
+		'F': false,
+		'|': false,
+		'J': true,
+		'L': false,
+		'S': true,
+	},
+	grid.DownVec: {
+		'.': false,
+		'-': false,
+		'7': false,
+		'F': false,
+		'|': true,
+		'J': true,
+		'L': true,
+		'S': true,
+	},
+	grid.LeftVec: {
+		'.': false,
+		'-': true,
+		'7': false,
+		'F': true,
+		'|': false,
+		'J': false,
+		'L': true,
+		'S': true,
+	},
+}
+
+// Get reports whether a tile c, reached by moving in dir, connects back. Unknown
+// directions and tiles never connect.
+func (m *ConnectionMap) Get(dir grid.Vector, c uint8) bool {
+	return (*m)[dir][c]
+}
+
+// isTile reports whether c is a tile that may appear in the pipe map.
+func isTile(c uint8) bool {
+	_, ok := connectionMap[grid.UpVec][c]
+	return ok
+}
+
+func getChar(pipes *grid.Grid[byte], pos grid.Vector) uint8 {
+	return pipes.GetOr(pos, '.')
+}
+
+func ConnectedVectors(pipes *grid.Grid[byte], pos grid.Vector) []grid.Vector {
+	vectors := []grid.Vector{}
+	dirsToCheck := []grid.Vector{}
+	origC := getChar(pipes, pos)
+
+	switch origC {
+	case '.':
+	case '-':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.RightVec,
+		}
+	case '7':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.DownVec,
+		}
+	case 'F':
+		dirsToCheck = []grid.Vector{
+			grid.DownVec, grid.RightVec,
+		}
+	case '|':
+		dirsToCheck = []grid.Vector{
+			grid.UpVec, grid.DownVec,
+		}
+	case 'J':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.UpVec,
+		}
+	case 'L':
+		dirsToCheck = []grid.Vector{
+			grid.UpVec, grid.RightVec,
+		}
+	case 'S':
+		dirsToCheck = grid.AllDirections
+	}
+
+	for _, dir := range dirsToCheck {
+		newP := pos.Add(dir)
+		newC := getChar(pipes, newP)
+		if connected := connectionMap.Get(dir, newC); connected {
+			vectors = append(vectors, dir)
+		}
+	}
+	return vectors
+}
+
+func Traverse(pipes *grid.Grid[byte], pos grid.Vector, distance int, distances map[grid.Vector]int) {
+
+	existingDist, ok := distances[pos]
+	if !ok {
+		distances[pos] = distance
+	} else if distance < existingDist {
+		distances[pos] = distance
+	} else {
+		return
+	}
+
+	connectedVectors := ConnectedVectors(pipes, pos)
+	for _, vec := range connectedVectors {
+		nextPos := pos.Add(vec)
+		Traverse(pipes, nextPos, distance+1, distances)
+	}
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	pipes, err := grid.ParseFunc(r, func(c byte) (byte, error) {
+		if !isTile(c) {
+			return 0, &aoc.ParseError{Expected: "tile (one of |-LJ7F.S)"}
+		}
+		return c, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	start, ok := pipes.IndexFunc(func(c byte) bool { return c == 'S' })
+	if !ok {
+		return "", errors.New("no start tile S in the pipe map")
+	}
+
+	distances := map[grid.Vector]int{}
+	Traverse(pipes, start, 0, distances)
+	longestDist := 0
+	for vec, distance := range distances {
+		if distance > longestDist {
+			longestDist = distance
+		}
+		aoc.Debugf("Vec: %v, distance: %d\n", vec, distance)
+	}
+	aoc.Debugf("Longest: %d\n", longestDist)
+	return strconv.Itoa(longestDist), nil
+}
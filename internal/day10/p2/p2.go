@@ -0,0 +1,224 @@
+package p2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+type ConnectionMap map[grid.Vector]map[uint8]bool
+
+var connectionMap = ConnectionMap{
+	grid.UpVec: {
+		'.': false,
+		'-': false,
+		'7': true,
+		'|': true,
+		'F': true,
+		'J': false,
+		'L': false,
+		'S': true,
+	},
+	grid.RightVec: {
+		'.': false,
+		'-': true,
+		'7': true,
+		'F': false,
+		'|': false,
+		'J': true,
+		'L': false,
+		'S': true,
+	},
+	grid.DownVec: {
+		'.': false,
+		'-': false,
+		'7': false,
+		'F': false,
+		'|': true,
+		'J': true,
+		'L': true,
+		'S': true,
+	},
+	grid.LeftVec: {
+		'.': false,
+		'-': true,
+		'7': false,
+		'F': true,
+		'|': false,
+		'J': false,
+		'L': true,
+		'S': true,
+	},
+}
+
+// Get reports whether a tile c, reached by moving in dir, connects back. Unknown
+// directions and tiles never connect.
+func (m *ConnectionMap) Get(dir grid.Vector, c uint8) bool {
+	return (*m)[dir][c]
+}
+
+// isTile reports whether c is a tile that may appear in the pipe map.
+func isTile(c uint8) bool {
+	_, ok := connectionMap[grid.UpVec][c]
+	return ok
+}
+
+func getChar(pipes *grid.Grid[byte], pos grid.Vector) uint8 {
+	return pipes.GetOr(pos, '.')
+}
+
+func ConnectedVectors(pipes *grid.Grid[byte], pos grid.Vector) []grid.Vector {
+	vectors := []grid.Vector{}
+	dirsToCheck := []grid.Vector{}
+	origC := getChar(pipes, pos)
+
+	switch origC {
+	case '.':
+	case '-':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.RightVec,
+		}
+	case '7':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.DownVec,
+		}
+	case 'F':
+		dirsToCheck = []grid.Vector{
+			grid.DownVec, grid.RightVec,
+		}
+	case '|':
+		dirsToCheck = []grid.Vector{
+			grid.UpVec, grid.DownVec,
+		}
+	case 'J':
+		dirsToCheck = []grid.Vector{
+			grid.LeftVec, grid.UpVec,
+		}
+	case 'L':
+		dirsToCheck = []grid.Vector{
+			grid.UpVec, grid.RightVec,
+		}
+	case 'S':
+		dirsToCheck = grid.AllDirections
+	}
+
+	for _, dir := range dirsToCheck {
+		newP := pos.Add(dir)
+		newC := getChar(pipes, newP)
+		if connected := connectionMap.Get(dir, newC); connected {
+			vectors = append(vectors, dir)
+		}
+	}
+	return vectors
+}
+
+func Traverse(pipes *grid.Grid[byte], pos grid.Vector, distance int, distances map[grid.Vector]int) {
+
+	existingDist, ok := distances[pos]
+	if !ok {
+		distances[pos] = distance
+	} else if distance < existingDist {
+		distances[pos] = distance
+	} else {
+		return
+	}
+
+	connectedVectors := ConnectedVectors(pipes, pos)
+	for _, vec := range connectedVectors {
+		nextPos := pos.Add(vec)
+		Traverse(pipes, nextPos, distance+1, distances)
+	}
+}
+
+// Loop returns the tiles of the loop through start, in the order they are
+// walked, or false when start isn't on a loop.
+func Loop(pipes *grid.Grid[byte], start grid.Vector) ([]grid.Vector, bool) {
+	for _, dir := range ConnectedVectors(pipes, start) {
+		loop := []grid.Vector{start}
+		pos := start.Add(dir)
+		for pos != start {
+			loop = append(loop, pos)
+			back := dir.Scale(-1)
+			dir = grid.Vector{}
+			for _, vec := range ConnectedVectors(pipes, pos) {
+				if vec != back {
+					dir = vec
+					break
+				}
+			}
+			if dir == (grid.Vector{}) {
+				break
+			}
+			pos = pos.Add(dir)
+		}
+		if pos == start {
+			return loop, true
+		}
+	}
+	return nil, false
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	pipes, err := grid.ParseFunc(r, func(c byte) (byte, error) {
+		if !isTile(c) {
+			return 0, &aoc.ParseError{Expected: "tile (one of |-LJ7F.S)"}
+		}
+		return c, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	start, ok := pipes.IndexFunc(func(c byte) bool { return c == 'S' })
+	if !ok {
+		return "", errors.New("no start tile S in the pipe map")
+	}
+
+	distances := map[grid.Vector]int{}
+	Traverse(pipes, start, 0, distances)
+	insideCount := 0
+	for y := 0; y < pipes.Height(); y++ {
+		for x := 0; x < pipes.Width(); x++ {
+			if _, ok := distances[grid.Vector{X: x, Y: y}]; ok {
+				continue
+			}
+			// Count the number of times we fully traverse the loop on our way out of the field.
+			// If we cross an odd number of times, we are inside the loop. If even or zero, we are out.
+			// "Fully traverse" meaning pass over a character where we actually go from one side to another.
+			// "Corners" like "L" and "7" do not cause us to cross over, if we move the cursor diagonally down and right.
+			// We remain on the same side as when we started passing over those characters.
+			xi, yi := x, y
+			crosses := 0
+			for xi < pipes.Width() && yi < pipes.Height() {
+				pos := grid.Vector{X: xi, Y: yi}
+				c := getChar(pipes, pos)
+				if _, ok := distances[pos]; ok && c != 'L' && c != '7' {
+					crosses += 1
+				}
+				xi += 1
+				yi += 1
+			}
+			if crosses%2 == 1 {
+				insideCount += 1
+				pipes.Set(grid.Vector{X: x, Y: y}, 'I')
+			}
+		}
+	}
+
+	aoc.Debugf("%s", pipes)
+	aoc.Debugf("InsideCount: %d\n", insideCount)
+	// Cross-check the ray casting: the loop's tiles are the vertices of a
+	// polygon, and Pick's theorem counts the tiles strictly inside it.
+	loop, ok := Loop(pipes, start)
+	if !ok {
+		return "", errors.New("no loop through the start tile S")
+	}
+	if picks := grid.Polygon(loop).Interior(); picks != insideCount {
+		return "", fmt.Errorf("ray casting found %d tiles inside the loop, but Pick's theorem %d", insideCount, picks)
+	}
+	return strconv.Itoa(insideCount), nil
+}
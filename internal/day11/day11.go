@@ -0,0 +1,67 @@
+// Package day11 holds the galaxy map shared by both parts of day 11.
+package day11
+
+import (
+	"io"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// Universe is a map of galaxies reduced to how many galaxies are in each row
+// and each column, which is all the distances between them depend on.
+type Universe struct {
+	Rows    []int
+	Columns []int
+}
+
+// Parse reads a map of galaxies (#) and empty space (.).
+func Parse(r io.Reader) (Universe, error) {
+	image, err := grid.ParseFunc(r, func(c byte) (bool, error) {
+		if c != '#' && c != '.' {
+			return false, &aoc.ParseError{Expected: `"#" or "."`}
+		}
+		return c == '#', nil
+	})
+	if err != nil {
+		return Universe{}, err
+	}
+
+	u := Universe{
+		Rows:    make([]int, image.Height()),
+		Columns: make([]int, image.Width()),
+	}
+	image.Each(func(pos grid.Vector, galaxy bool) {
+		if galaxy {
+			u.Rows[pos.Y]++
+			u.Columns[pos.X]++
+		}
+	})
+	return u, nil
+}
+
+// SumOfDistances is the sum of the shortest paths between every pair of
+// galaxies once each empty row and column has grown to expansion rows or
+// columns.
+func (u Universe) SumOfDistances(expansion int) int {
+	return axisDistances(u.Rows, expansion) + axisDistances(u.Columns, expansion)
+}
+
+// axisDistances sums the distances along one axis between every pair of
+// galaxies, given how many galaxies there are at each position on the axis.
+// Walking the axis in order, each galaxy is pos away from every galaxy seen
+// so far minus where they were, so a running sum of those positions is enough.
+func axisDistances(counts []int, expansion int) int {
+	sum, seen, seenPosSum, pos := 0, 0, 0, 0
+	for _, count := range counts {
+		if count == 0 {
+			pos += expansion
+			continue
+		}
+		sum += count * (pos*seen - seenPosSum)
+		seen += count
+		seenPosSum += count * pos
+		pos++
+	}
+	return sum
+}
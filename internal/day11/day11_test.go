@@ -0,0 +1,34 @@
+package day11
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSumOfDistances(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	universe, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The puzzle text gives the sums for these expansions of the example.
+	tests := []struct {
+		expansion, want int
+	}{
+		{1, 292},
+		{2, 374},
+		{10, 1030},
+		{100, 8410},
+	}
+	for _, tt := range tests {
+		if got := universe.SumOfDistances(tt.expansion); got != tt.want {
+			t.Errorf("SumOfDistances(%d) = %d, want %d", tt.expansion, got, tt.want)
+		}
+	}
+}
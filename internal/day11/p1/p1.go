@@ -0,0 +1,25 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day11"
+)
+
+// expansion is how many rows or columns each empty one grows to.
+const expansion = 2
+
+func Solve(r io.Reader) (string, error) {
+
+	universe, err := day11.Parse(r)
+	if err != nil {
+		return "", err
+	}
+	aoc.Debugf("Rows: %v\nColumns: %v\n", universe.Rows, universe.Columns)
+
+	score := universe.SumOfDistances(expansion)
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
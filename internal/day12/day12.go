@@ -0,0 +1,131 @@
+// Package day12 holds the condition records shared by both parts of day 12.
+package day12
+
+import (
+	"io"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Record is one row of the condition records: springs that are operational
+// (.), damaged (#) or unknown (?), and the sizes of each contiguous group of
+// damaged springs, in order.
+type Record struct {
+	Springs string
+	Groups  []int
+}
+
+// ParseRecord parses one line of the condition records.
+func ParseRecord(line string) (Record, error) {
+	var springs, groups parse.Field
+	if err := parse.Scanf(line, "{word} {word}", &springs, &groups); err != nil {
+		return Record{}, err
+	}
+	if i := strings.IndexFunc(springs.Text, func(c rune) bool { return !strings.ContainsRune(".#?", c) }); i >= 0 {
+		return Record{}, springs.Slice(i, i+1).Expected(`".", "#" or "?"`, nil)
+	}
+
+	record := Record{Springs: springs.Text}
+	for _, group := range groups.Split(",") {
+		size, err := group.Int()
+		if err != nil {
+			return Record{}, err
+		}
+		if size < 1 {
+			return Record{}, group.Expected("a positive group size", nil)
+		}
+		record.Groups = append(record.Groups, size)
+	}
+	return record, nil
+}
+
+// Unfold returns the record copied times times, the copies of its springs
+// joined by unknowns.
+func (rec Record) Unfold(times int) Record {
+	springs := make([]string, times)
+	groups := make([]int, 0, len(rec.Groups)*times)
+	for i := 0; i < times; i++ {
+		springs[i] = rec.Springs
+		groups = append(groups, rec.Groups...)
+	}
+	return Record{Springs: strings.Join(springs, "?"), Groups: groups}
+}
+
+// Arrangements counts the ways the unknown springs can be operational or
+// damaged such that the damaged ones form exactly the record's groups.
+func (rec Record) Arrangements() int {
+	maxGroup := 0
+	for _, size := range rec.Groups {
+		maxGroup = max(maxGroup, size)
+	}
+	a := arranger{
+		Record: rec,
+		memo:   make([]int, (len(rec.Springs)+1)*(len(rec.Groups)+1)*(maxGroup+1)),
+		groups: len(rec.Groups) + 1,
+		runs:   maxGroup + 1,
+	}
+	for i := range a.memo {
+		a.memo[i] = -1
+	}
+	return a.count(0, 0, 0)
+}
+
+// arranger memoizes the arrangements of the springs from pos on, given that
+// group groups are complete and the springs just before pos end a run of run
+// damaged ones towards the next.
+type arranger struct {
+	Record
+	memo         []int
+	groups, runs int
+}
+
+func (a *arranger) count(pos, group, run int) int {
+	if pos == len(a.Springs) {
+		if run == 0 && group == len(a.Groups) || group == len(a.Groups)-1 && run == a.Groups[group] {
+			return 1
+		}
+		return 0
+	}
+
+	key := (pos*a.groups+group)*a.runs + run
+	if a.memo[key] >= 0 {
+		return a.memo[key]
+	}
+
+	total := 0
+	c := a.Springs[pos]
+	if (c == '#' || c == '?') && group < len(a.Groups) && run < a.Groups[group] {
+		total += a.count(pos+1, group, run+1)
+	}
+	if c == '.' || c == '?' {
+		if run == 0 {
+			total += a.count(pos+1, group, 0)
+		} else if run == a.Groups[group] {
+			total += a.count(pos+1, group+1, 0)
+		}
+	}
+	a.memo[key] = total
+	return total
+}
+
+// Counts returns the number of arrangements of each record in r, after
+// unfolding it unfold times.
+func Counts(r io.Reader, unfold int) ([]int, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]int, 0, len(lines))
+	for i, line := range lines {
+		record, err := ParseRecord(line)
+		if err != nil {
+			return nil, parse.AtLine(err, i+1, line)
+		}
+		count := record.Unfold(unfold).Arrangements()
+		aoc.Debugf("%s: %d\n", line, count)
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
@@ -0,0 +1,64 @@
+package day12
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+func TestCounts(t *testing.T) {
+	// The puzzle text gives the arrangements of each row of the example,
+	// folded and unfolded.
+	tests := []struct {
+		unfold int
+		want   []int
+	}{
+		{1, []int{1, 4, 1, 1, 4, 10}},
+		{5, []int{1, 16384, 1, 16, 2500, 506250}},
+	}
+	for _, tt := range tests {
+		f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Counts(f, tt.unfold)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Counts(unfold %d) = %v, want %v", tt.unfold, got, tt.want)
+		}
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	got := Record{Springs: ".#", Groups: []int{1}}.Unfold(5)
+	want := Record{Springs: ".#?.#?.#?.#?.#", Groups: []int{1, 1, 1, 1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unfold(5) = %v, want %v", got, want)
+	}
+}
+
+func TestParseRecordErrors(t *testing.T) {
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"??x.### 1,1,3", 3},
+		{"???.### 1,a,3", 11},
+		{"???.### 1,0,3", 11},
+		{"???.###", 1},
+	}
+	for _, tt := range tests {
+		_, err := ParseRecord(tt.line)
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Column != tt.column {
+			t.Errorf("ParseRecord(%q) = %v, want a ParseError at column %d", tt.line, err, tt.column)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day12"
+)
+
+// unfold is 1 as part 1 takes the records as they are written.
+const unfold = 1
+
+func Solve(r io.Reader) (string, error) {
+
+	counts, err := day12.Counts(r, unfold)
+	if err != nil {
+		return "", err
+	}
+
+	score := 0
+	for _, count := range counts {
+		score += count
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
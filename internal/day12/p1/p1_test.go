@@ -0,0 +1,25 @@
+package p1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	const want = "21"
+
+	f, err := os.Open(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	got, err := Solve(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Solve(example.txt) = %s, want %s", got, want)
+	}
+}
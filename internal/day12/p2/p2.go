@@ -0,0 +1,27 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day12"
+)
+
+// unfold is how many copies of itself each record really is.
+const unfold = 5
+
+func Solve(r io.Reader) (string, error) {
+
+	counts, err := day12.Counts(r, unfold)
+	if err != nil {
+		return "", err
+	}
+
+	score := 0
+	for _, count := range counts {
+		score += count
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,134 @@
+// Package day13 holds the mirror finding shared by both parts of day 13.
+package day13
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type Axis int
+
+const (
+	// Vertical is a line of reflection between two columns.
+	Vertical Axis = iota
+	// Horizontal is a line of reflection between two rows.
+	Horizontal
+)
+
+func (a Axis) String() string {
+	if a == Horizontal {
+		return "horizontal"
+	}
+	return "vertical"
+}
+
+// Reflection is a line of reflection, Index rows above or columns left of it.
+type Reflection struct {
+	Axis  Axis
+	Index int
+}
+
+// Summary is the reflection's contribution to the puzzle answer.
+func (r Reflection) Summary() int {
+	if r.Axis == Horizontal {
+		return 100 * r.Index
+	}
+	return r.Index
+}
+
+// Pattern is a field of ash and rocks, each row and column held as a bitmask
+// of where the rocks are.
+type Pattern struct {
+	Rows    []uint64
+	Columns []uint64
+}
+
+// NewPattern converts a grid of rocks, where true is a rock.
+func NewPattern(rocks *grid.Grid[bool]) Pattern {
+	p := Pattern{
+		Rows:    make([]uint64, rocks.Height()),
+		Columns: make([]uint64, rocks.Width()),
+	}
+	rocks.Each(func(pos grid.Vector, rock bool) {
+		if rock {
+			p.Rows[pos.Y] |= 1 << pos.X
+			p.Columns[pos.X] |= 1 << pos.Y
+		}
+	})
+	return p
+}
+
+// Reflection finds the line of reflection across which exactly differences
+// cells don't match their mirror image, preferring a vertical one.
+func (p Pattern) Reflection(differences int) (Reflection, bool) {
+	if i, ok := reflectionIndex(p.Columns, differences); ok {
+		return Reflection{Axis: Vertical, Index: i}, true
+	}
+	if i, ok := reflectionIndex(p.Rows, differences); ok {
+		return Reflection{Axis: Horizontal, Index: i}, true
+	}
+	return Reflection{}, false
+}
+
+// reflectionIndex finds how many lines come before a mirror across which
+// exactly differences bits of lines don't match.
+func reflectionIndex(lines []uint64, differences int) (int, bool) {
+	for i := 1; i < len(lines); i++ {
+		diff := 0
+		for above, below := i-1, i; above >= 0 && below < len(lines) && diff <= differences; above, below = above-1, below+1 {
+			diff += bits.OnesCount64(lines[above] ^ lines[below])
+		}
+		if diff == differences {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ParsePattern reads a pattern of ash (.) and rocks (#).
+func ParsePattern(p parse.Paragraph) (Pattern, error) {
+	rocks, err := grid.FromLinesFunc(p.Lines, p.Line, isRock)
+	if err != nil {
+		return Pattern{}, err
+	}
+	if rocks.Width() > 64 || rocks.Height() > 64 {
+		return Pattern{}, &aoc.ParseError{Line: p.Line, Text: p.Lines[0], Expected: "a pattern at most 64 wide and high"}
+	}
+	return NewPattern(rocks), nil
+}
+
+func isRock(c byte) (bool, error) {
+	if c != '#' && c != '.' {
+		return false, &aoc.ParseError{Expected: `"#" or "."`}
+	}
+	return c == '#', nil
+}
+
+// Summarize sums the summaries of the reflections of every pattern in r,
+// which are separated by blank lines, each found allowing differences
+// mismatched cells.
+func Summarize(r io.Reader, differences int) (int, error) {
+	paragraphs, err := parse.Paragraphs(r)
+	if err != nil {
+		return 0, err
+	}
+	sum := 0
+	for i, paragraph := range paragraphs {
+		pattern, err := ParsePattern(paragraph)
+		if err != nil {
+			return 0, err
+		}
+		reflection, ok := pattern.Reflection(differences)
+		if !ok {
+			return 0, &aoc.ParseError{Line: paragraph.Line, Text: paragraph.Lines[0], Expected: fmt.Sprintf("a pattern with a line of reflection with %d differences", differences)}
+		}
+		aoc.Debugf("pattern %d (line %d): %s reflection at %d\n", i+1, paragraph.Line, reflection.Axis, reflection.Index)
+		sum += reflection.Summary()
+	}
+	return sum, nil
+}
@@ -0,0 +1,40 @@
+package day13
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+func TestReflection(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	paragraphs, err := parse.Paragraphs(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The puzzle text gives the lines of reflection of the example's two
+	// patterns, without and with the smudge.
+	want := map[int][]Reflection{
+		0: {{Axis: Vertical, Index: 5}, {Axis: Horizontal, Index: 4}},
+		1: {{Axis: Horizontal, Index: 3}, {Axis: Horizontal, Index: 1}},
+	}
+	for i, paragraph := range paragraphs {
+		pattern, err := ParsePattern(paragraph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for differences, want := range want {
+			got, ok := pattern.Reflection(differences)
+			if !ok || got != want[i] {
+				t.Errorf("pattern %d: Reflection(%d) = %v, %v, want %v", i+1, differences, got, ok, want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day13"
+)
+
+// differences is how many cells may differ from their reflection.
+const differences = 0
+
+func Solve(r io.Reader) (string, error) {
+
+	score, err := day13.Summarize(r, differences)
+	if err != nil {
+		return "", err
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,81 @@
+// Package day14 holds the tilting platform shared by both parts of day 14.
+package day14
+
+import (
+	"io"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+const (
+	roundRock = 'O'
+	cubeRock  = '#'
+	empty     = '.'
+)
+
+// Parse reads a platform of round rocks (O), cube-shaped rocks (#) and empty
+// space (.).
+func Parse(r io.Reader) (*grid.Grid[byte], error) {
+	return grid.ParseFunc(r, func(c byte) (byte, error) {
+		if c != roundRock && c != cubeRock && c != empty {
+			return 0, &aoc.ParseError{Expected: `"O", "#" or "."`}
+		}
+		return c, nil
+	})
+}
+
+// Tilt rolls every round rock on platform as far as it goes in dir.
+func Tilt(platform *grid.Grid[byte], dir grid.Vector) {
+	// Move the rocks nearest the edge they roll towards first, so each one
+	// comes to rest against those already moved.
+	for _, y := range span(platform.Height(), dir.Y > 0) {
+		for _, x := range span(platform.Width(), dir.X > 0) {
+			pos := grid.Vector{X: x, Y: y}
+			if c, _ := platform.Get(pos); c != roundRock {
+				continue
+			}
+			rest := pos
+			for platform.GetOr(rest.Add(dir), cubeRock) == empty {
+				rest = rest.Add(dir)
+			}
+			platform.Set(pos, empty)
+			platform.Set(rest, roundRock)
+		}
+	}
+}
+
+// span is 0 to n-1, backwards when reverse is set.
+func span(n int, reverse bool) []int {
+	s := make([]int, n)
+	for i := range s {
+		if reverse {
+			s[i] = n - 1 - i
+		} else {
+			s[i] = i
+		}
+	}
+	return s
+}
+
+// SpinCycle returns platform after tilting a copy of it north, west, south and
+// then east.
+func SpinCycle(platform *grid.Grid[byte]) *grid.Grid[byte] {
+	spun := platform.Clone()
+	for _, dir := range []grid.Vector{grid.UpVec, grid.LeftVec, grid.DownVec, grid.RightVec} {
+		Tilt(spun, dir)
+	}
+	return spun
+}
+
+// NorthLoad is the load on the north support beams: each round rock weighs as
+// many rows as it is from the south edge.
+func NorthLoad(platform *grid.Grid[byte]) int {
+	load := 0
+	platform.Each(func(pos grid.Vector, c byte) {
+		if c == roundRock {
+			load += platform.Height() - pos.Y
+		}
+	})
+	return load
+}
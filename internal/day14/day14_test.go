@@ -0,0 +1,41 @@
+package day14
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSpinCycle(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	platform, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The puzzle text shows the example after each of the first three cycles.
+	want := []string{
+		".....#....\n....#...O#\n...OO##...\n.OO#......\n.....OOO#.\n.O#...O#.#\n....O#....\n......OOOO\n#...O###..\n#..OO#....\n",
+		".....#....\n....#...O#\n.....##...\n..O#......\n.....OOO#.\n.O#...O#.#\n....O#...O\n.......OOO\n#..OO###..\n#.OOO#...O\n",
+		".....#....\n....#...O#\n.....##...\n..O#......\n.....OOO#.\n.O#...O#.#\n....O#...O\n.......OOO\n#...O###.O\n#.OOO#...O\n",
+	}
+	before := platform.String()
+	spun := platform
+	for i, w := range want {
+		spun = SpinCycle(spun)
+		if got := spun.String(); got != w {
+			t.Errorf("after %d cycles:\n%s\nwant:\n%s", i+1, got, w)
+		}
+	}
+	if platform.String() != before {
+		t.Error("SpinCycle() changed the platform it was given")
+	}
+	if strings.Count(spun.String(), "O") != strings.Count(before, "O") {
+		t.Error("SpinCycle() lost or made rocks")
+	}
+}
@@ -0,0 +1,24 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day14"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	platform, err := day14.Parse(r)
+	if err != nil {
+		return "", err
+	}
+	day14.Tilt(platform, grid.UpVec)
+	aoc.Debugf("%s", platform)
+
+	score := day14.NorthLoad(platform)
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
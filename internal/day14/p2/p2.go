@@ -0,0 +1,30 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day14"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+	"github.com/HugoKlepsch/AoC2023/internal/sim"
+)
+
+const spinCycles = 1000000000
+
+func Solve(r io.Reader) (string, error) {
+
+	platform, err := day14.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	cycle := sim.FindCycle(platform, day14.SpinCycle, (*grid.Grid[byte]).String)
+	aoc.Debugf("Spin cycles repeat every %d from cycle %d\n", cycle.Period, cycle.Start)
+	platform = cycle.At(spinCycles)
+	aoc.Debugf("%s", platform)
+
+	score := day14.NorthLoad(platform)
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
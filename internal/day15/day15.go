@@ -0,0 +1,139 @@
+// Package day15 holds the HASH algorithm and lens boxes shared by both parts
+// of day 15.
+package day15
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Hash is the Holiday ASCII String Helper algorithm.
+func Hash(s string) int {
+	h := 0
+	for i := 0; i < len(s); i++ {
+		h = (h + int(s[i])) * 17 % 256
+	}
+	return h
+}
+
+// EachStep calls fn with each step of the comma separated initialization
+// sequence in r.
+func EachStep(r io.Reader, fn func(step parse.Field) error) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		for _, step := range parse.Split(line, ",") {
+			if step.Text == "" {
+				return parse.AtLine(step.Expected("a step", nil), i+1, line)
+			}
+			if err := fn(step); err != nil {
+				return parse.AtLine(err, i+1, line)
+			}
+		}
+	}
+	return nil
+}
+
+// Step is one step of the initialization sequence: either removing the lens
+// labelled Label, or, when Focal isn't 0, putting in a lens of that focal
+// length.
+type Step struct {
+	Label string
+	Focal int
+}
+
+// ParseStep parses a step such as "rn=1" or "cm-".
+func ParseStep(f parse.Field) (Step, error) {
+	i := strings.IndexAny(f.Text, "=-")
+	if i < 1 {
+		return Step{}, f.Expected(`"<label>=<focal length>" or "<label>-"`, nil)
+	}
+	step := Step{Label: f.Text[:i]}
+	if f.Text[i] == '-' {
+		if i != len(f.Text)-1 {
+			return Step{}, f.Slice(i+1, len(f.Text)).Expected("the end of the step", nil)
+		}
+		return step, nil
+	}
+
+	focal := f.Slice(i+1, len(f.Text))
+	var err error
+	step.Focal, err = focal.Int()
+	if err != nil {
+		return Step{}, err
+	}
+	if step.Focal < 1 || step.Focal > 9 {
+		return Step{}, focal.Expected("a focal length from 1 to 9", nil)
+	}
+	return step, nil
+}
+
+func (s Step) String() string {
+	if s.Focal == 0 {
+		return s.Label + "-"
+	}
+	return fmt.Sprintf("%s=%d", s.Label, s.Focal)
+}
+
+type Lens struct {
+	Label string
+	Focal int
+}
+
+// Boxes are the 256 boxes of lenses, each in the order they were put in.
+type Boxes [256][]Lens
+
+// Apply performs step on the box its label hashes to.
+func (b *Boxes) Apply(step Step) {
+	box := &b[Hash(step.Label)]
+	for i, lens := range *box {
+		if lens.Label != step.Label {
+			continue
+		}
+		if step.Focal == 0 {
+			*box = append((*box)[:i], (*box)[i+1:]...)
+		} else {
+			(*box)[i].Focal = step.Focal
+		}
+		return
+	}
+	if step.Focal != 0 {
+		*box = append(*box, Lens{Label: step.Label, Focal: step.Focal})
+	}
+}
+
+// FocusingPower sums, for every lens, one more than its box number times its
+// slot in the box, counting from 1, times its focal length.
+func (b *Boxes) FocusingPower() int {
+	power := 0
+	for boxNo, box := range b {
+		for slot, lens := range box {
+			power += (boxNo + 1) * (slot + 1) * lens.Focal
+		}
+	}
+	return power
+}
+
+// String lists the boxes that hold lenses as the puzzle's walkthrough does.
+func (b *Boxes) String() string {
+	var sb strings.Builder
+	for boxNo, box := range b {
+		if len(box) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "Box %d:", boxNo)
+		for _, lens := range box {
+			fmt.Fprintf(&sb, " [%s %d]", lens.Label, lens.Focal)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+package day15
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+func TestHash(t *testing.T) {
+	// The puzzle text hashes "HASH" and each step of the example.
+	tests := map[string]int{
+		"HASH": 52,
+		"rn=1": 30, "cm-": 253, "qp=3": 97, "cm=2": 47, "qp-": 14, "pc=4": 180,
+		"ot=9": 9, "ab=5": 197, "pc-": 48, "pc=6": 214, "ot=7": 231,
+		"rn": 0, "qp": 1, "pc": 3,
+	}
+	for s, want := range tests {
+		if got := Hash(s); got != want {
+			t.Errorf("Hash(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestEachStepReadsLongLines(t *testing.T) {
+	const steps = 20000
+	line := strings.TrimSuffix(strings.Repeat("rn=1,", steps), ",")
+	count := 0
+	err := EachStep(strings.NewReader(line+"\n"), func(step parse.Field) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != steps {
+		t.Errorf("EachStep() saw %d steps of a %d byte line, want %d", count, len(line), steps)
+	}
+}
+
+func TestParseStep(t *testing.T) {
+	tests := []struct {
+		text   string
+		want   Step
+		column int
+	}{
+		{text: "rn=1", want: Step{Label: "rn", Focal: 1}},
+		{text: "cm-", want: Step{Label: "cm"}},
+		{text: "=1", column: 1},
+		{text: "rn", column: 1},
+		{text: "rn=0", column: 4},
+		{text: "rn=x", column: 4},
+		{text: "rn-1", column: 4},
+	}
+	for _, tt := range tests {
+		got, err := ParseStep(parse.Line(tt.text))
+		if tt.column == 0 {
+			if err != nil || got != tt.want {
+				t.Errorf("ParseStep(%q) = %v, %v, want %v", tt.text, got, err, tt.want)
+			}
+			continue
+		}
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Column != tt.column {
+			t.Errorf("ParseStep(%q) = %v, want a ParseError at column %d", tt.text, err, tt.column)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day15"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	score := 0
+	err := day15.EachStep(r, func(step parse.Field) error {
+		hash := day15.Hash(step.Text)
+		aoc.Debugf("%s becomes %d\n", step.Text, hash)
+		score += hash
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,31 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day15"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	var boxes day15.Boxes
+	err := day15.EachStep(r, func(f parse.Field) error {
+		step, err := day15.ParseStep(f)
+		if err != nil {
+			return err
+		}
+		boxes.Apply(step)
+		aoc.Debugf("After %q:\n%s\n", step, &boxes)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	score := boxes.FocusingPower()
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
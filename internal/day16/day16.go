@@ -0,0 +1,150 @@
+// Package day16 holds the beam tracing shared by both parts of day 16.
+package day16
+
+import (
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// Parse reads a contraption of empty space (.), mirrors (/ and \) and
+// splitters (| and -).
+func Parse(r io.Reader) (*grid.Grid[byte], error) {
+	return grid.ParseFunc(r, func(c byte) (byte, error) {
+		switch c {
+		case '.', '/', '\\', '|', '-':
+			return c, nil
+		}
+		return 0, &aoc.ParseError{Expected: `one of ./\|-`}
+	})
+}
+
+// Beam is the front of a beam of light: where it is and which way it's going.
+type Beam struct {
+	Pos grid.Vector
+	Dir grid.Vector
+}
+
+// dirBit is the bit recording in a tile's visited mask that a beam has passed
+// through it going in dir.
+func dirBit(dir grid.Vector) uint8 {
+	switch dir {
+	case grid.UpVec:
+		return 1
+	case grid.RightVec:
+		return 2
+	case grid.DownVec:
+		return 4
+	}
+	return 8
+}
+
+// next is where the beam goes after the tile it is on, c.
+func (b Beam) next(c byte) []grid.Vector {
+	switch {
+	case c == '/':
+		return []grid.Vector{{X: -b.Dir.Y, Y: -b.Dir.X}}
+	case c == '\\':
+		return []grid.Vector{{X: b.Dir.Y, Y: b.Dir.X}}
+	case c == '|' && b.Dir.X != 0:
+		return []grid.Vector{grid.UpVec, grid.DownVec}
+	case c == '-' && b.Dir.Y != 0:
+		return []grid.Vector{grid.LeftVec, grid.RightVec}
+	}
+	return []grid.Vector{b.Dir}
+}
+
+// Energized counts the tiles of contraption that a beam entering at start
+// passes through. A beam that comes back to a tile going the same way as
+// before would only retrace its path, so it stops there.
+func Energized(contraption *grid.Grid[byte], start Beam) int {
+	visited := grid.New[uint8](contraption.Width(), contraption.Height())
+	energized := 0
+	beams := []Beam{start}
+	for len(beams) > 0 {
+		b := beams[len(beams)-1]
+		beams = beams[:len(beams)-1]
+
+		c, ok := contraption.Get(b.Pos)
+		if !ok {
+			continue
+		}
+		seen, _ := visited.Get(b.Pos)
+		if seen&dirBit(b.Dir) != 0 {
+			continue
+		}
+		if seen == 0 {
+			energized++
+		}
+		visited.Set(b.Pos, seen|dirBit(b.Dir))
+
+		for _, dir := range b.next(c) {
+			beams = append(beams, Beam{Pos: b.Pos.Add(dir), Dir: dir})
+		}
+	}
+	return energized
+}
+
+// Entries are the beams that could enter contraption: any edge tile, heading
+// away from that edge.
+func Entries(contraption *grid.Grid[byte]) []Beam {
+	w, h := contraption.Width(), contraption.Height()
+	var entries []Beam
+	for x := 0; x < w; x++ {
+		entries = append(entries,
+			Beam{Pos: grid.Vector{X: x, Y: 0}, Dir: grid.DownVec},
+			Beam{Pos: grid.Vector{X: x, Y: h - 1}, Dir: grid.UpVec})
+	}
+	for y := 0; y < h; y++ {
+		entries = append(entries,
+			Beam{Pos: grid.Vector{X: 0, Y: y}, Dir: grid.RightVec},
+			Beam{Pos: grid.Vector{X: w - 1, Y: y}, Dir: grid.LeftVec})
+	}
+	return entries
+}
+
+// MostEnergized tries every entry into contraption, spread across a goroutine
+// per CPU, and returns the entry energizing the most tiles and how many that
+// is. An empty contraption has no entries, and energizes nothing.
+func MostEnergized(contraption *grid.Grid[byte]) (Beam, int) {
+	entries := make(chan Beam)
+	type result struct {
+		entry     Beam
+		energized int
+	}
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var best result
+			for entry := range entries {
+				if energized := Energized(contraption, entry); energized > best.energized {
+					best = result{entry: entry, energized: energized}
+				}
+			}
+			results <- best
+		}()
+	}
+	go func() {
+		for _, entry := range Entries(contraption) {
+			entries <- entry
+		}
+		close(entries)
+		wg.Wait()
+		close(results)
+	}()
+
+	var best result
+	for r := range results {
+		if r.energized > best.energized {
+			best = r
+		}
+	}
+	return best.entry, best.energized
+}
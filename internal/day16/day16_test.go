@@ -0,0 +1,57 @@
+package day16
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func TestEnergizedStopsOnLoops(t *testing.T) {
+	// The beam splits at the first | and circles the mirrors forever.
+	contraption, err := Parse(strings.NewReader(".|..\\\n....|\n.\\../\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Energized(contraption, Beam{Dir: grid.RightVec}); got != 11 {
+		t.Errorf("Energized() = %d, want 11", got)
+	}
+}
+
+func TestMostEnergized(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	contraption, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := Entries(contraption)
+	if len(entries) != 40 {
+		t.Errorf("Entries() = %d beams, want 40", len(entries))
+	}
+	want := 0
+	for _, entry := range entries {
+		want = max(want, Energized(contraption, entry))
+	}
+
+	entry, got := MostEnergized(contraption)
+	if got != want || Energized(contraption, entry) != want {
+		t.Errorf("MostEnergized() = %v, %d, want %d", entry, got, want)
+	}
+}
+
+func TestMostEnergizedEmpty(t *testing.T) {
+	contraption, err := Parse(strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, got := MostEnergized(contraption); got != 0 {
+		t.Errorf("MostEnergized(empty) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,22 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day16"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	contraption, err := day16.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := day16.Energized(contraption, day16.Beam{Dir: grid.RightVec})
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,22 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day16"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	contraption, err := day16.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	entry, score := day16.MostEnergized(contraption)
+	aoc.Debugf("Best entry: %v heading %v\n", entry.Pos, entry.Dir)
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,158 @@
+// Package day17 holds the crucible path finding shared by both parts of day
+// 17.
+package day17
+
+import (
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+	"github.com/HugoKlepsch/AoC2023/internal/pqueue"
+)
+
+// Parse reads a map of how much heat is lost entering each city block.
+func Parse(r io.Reader) (*grid.Grid[int], error) {
+	city, err := grid.ParseFunc(r, func(c byte) (int, error) {
+		if c < '1' || c > '9' {
+			return 0, &aoc.ParseError{Expected: "a heat loss from 1 to 9"}
+		}
+		return int(c - '0'), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if city.Width() == 0 || city.Height() == 0 {
+		return nil, &aoc.ParseError{Line: 1, Expected: "a map of city blocks"}
+	}
+	return city, nil
+}
+
+// Crucible limits how far a crucible moves in a straight line: at least MinRun
+// blocks before it may turn or stop, and at most MaxRun.
+type Crucible struct {
+	MinRun, MaxRun int
+}
+
+// Step is a block on a path and the direction the crucible entered it in.
+type Step struct {
+	Pos grid.Vector
+	Dir grid.Vector
+}
+
+// state is where a crucible is, which of grid.AllDirections it is heading in
+// and how many blocks it has gone that way.
+type state struct {
+	pos      grid.Vector
+	dir, run int
+}
+
+type queued struct {
+	state
+	heatLoss int
+}
+
+// LeastHeatLoss finds the path from the top left block of city to the bottom
+// right one losing the least heat, using Dijkstra's algorithm over the states
+// the crucible can be in. It returns the heat lost and the path, without the
+// starting block, or false when the crucible can't get there at all. On a
+// single block, the crucible is there already and loses nothing.
+func (c Crucible) LeastHeatLoss(city *grid.Grid[int]) (int, []Step, bool) {
+	end := grid.Vector{X: city.Width() - 1, Y: city.Height() - 1}
+	if end == (grid.Vector{}) {
+		return 0, nil, true
+	}
+
+	index := func(s state) int {
+		return ((s.pos.Y*city.Width()+s.pos.X)*len(grid.AllDirections)+s.dir)*(c.MaxRun+1) + s.run
+	}
+	size := city.Width() * city.Height() * len(grid.AllDirections) * (c.MaxRun + 1)
+	heatLoss := make([]int, size)
+	prev := make([]state, size)
+	for i := range heatLoss {
+		heatLoss[i] = math.MaxInt
+	}
+
+	queue := pqueue.New(func(a, b queued) bool { return a.heatLoss < b.heatLoss })
+	// The crucible hasn't moved yet, so may set off either way.
+	for _, dir := range []int{1, 2} {
+		start := state{dir: dir}
+		heatLoss[index(start)] = 0
+		queue.Push(queued{state: start})
+	}
+
+	for queue.Len() > 0 {
+		q := queue.Pop()
+		if q.heatLoss > heatLoss[index(q.state)] {
+			continue
+		}
+		if q.pos == end && q.run >= c.MinRun {
+			return q.heatLoss, c.path(q.state, prev, index), true
+		}
+
+		for _, next := range c.moves(q.state) {
+			loss, ok := city.Get(next.pos)
+			if !ok {
+				continue
+			}
+			loss += q.heatLoss
+			if i := index(next); loss < heatLoss[i] {
+				heatLoss[i] = loss
+				prev[i] = q.state
+				queue.Push(queued{state: next, heatLoss: loss})
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+// moves are the states a crucible in s can move to next.
+func (c Crucible) moves(s state) []state {
+	var moves []state
+	if s.run < c.MaxRun {
+		moves = append(moves, state{pos: s.pos.Add(grid.AllDirections[s.dir]), dir: s.dir, run: s.run + 1})
+	}
+	if s.run >= c.MinRun || s.run == 0 {
+		for _, turn := range []int{1, 3} {
+			dir := (s.dir + turn) % len(grid.AllDirections)
+			moves = append(moves, state{pos: s.pos.Add(grid.AllDirections[dir]), dir: dir, run: 1})
+		}
+	}
+	return moves
+}
+
+// path walks back from s to the start.
+func (c Crucible) path(s state, prev []state, index func(state) int) []Step {
+	var path []Step
+	for s.run > 0 {
+		path = append(path, Step{Pos: s.pos, Dir: grid.AllDirections[s.dir]})
+		s = prev[index(s)]
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+var arrows = map[grid.Vector]string{
+	grid.UpVec:    "^",
+	grid.RightVec: ">",
+	grid.DownVec:  "v",
+	grid.LeftVec:  "<",
+}
+
+// Overlay draws path over city as the puzzle does, with an arrow for each
+// block the path enters.
+func Overlay(city *grid.Grid[int], path []Step) string {
+	onPath := map[grid.Vector]grid.Vector{}
+	for _, step := range path {
+		onPath[step.Pos] = step.Dir
+	}
+	return city.Format(func(pos grid.Vector, loss int) string {
+		if dir, ok := onPath[pos]; ok {
+			return arrows[dir]
+		}
+		return strconv.Itoa(loss)
+	})
+}
@@ -0,0 +1,81 @@
+package day17
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func TestLeastHeatLossPath(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	city, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []Crucible{{MinRun: 1, MaxRun: 3}, {MinRun: 4, MaxRun: 10}} {
+		heatLoss, path, ok := c.LeastHeatLoss(city)
+		if !ok {
+			t.Fatalf("%+v: no path", c)
+		}
+
+		// The path must lose the heat claimed, and keep to the crucible's runs.
+		sum, run := 0, 0
+		pos := grid.Vector{}
+		for i, step := range path {
+			if step.Pos != pos.Add(step.Dir) {
+				t.Fatalf("%+v: step %d to %v doesn't follow from %v", c, i, step.Pos, pos)
+			}
+			if i > 0 && step.Dir != path[i-1].Dir {
+				if run < c.MinRun {
+					t.Errorf("%+v: turned at %v after %d blocks", c, pos, run)
+				}
+				run = 0
+			}
+			run++
+			if run > c.MaxRun {
+				t.Errorf("%+v: went %d blocks straight to %v", c, run, step.Pos)
+			}
+			loss, _ := city.Get(step.Pos)
+			sum += loss
+			pos = step.Pos
+		}
+		if sum != heatLoss {
+			t.Errorf("%+v: path loses %d, LeastHeatLoss() = %d", c, sum, heatLoss)
+		}
+		if end := (grid.Vector{X: city.Width() - 1, Y: city.Height() - 1}); pos != end {
+			t.Errorf("%+v: path ends at %v, want %v", c, pos, end)
+		}
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, input := range []string{"", "\n\n"} {
+		_, err := Parse(strings.NewReader(input))
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Parse(%q) = %v, want a ParseError", input, err)
+		}
+	}
+}
+
+func TestLeastHeatLossOneBlock(t *testing.T) {
+	city, err := Parse(strings.NewReader("7\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []Crucible{{MinRun: 1, MaxRun: 3}, {MinRun: 4, MaxRun: 10}} {
+		if heatLoss, path, ok := c.LeastHeatLoss(city); !ok || heatLoss != 0 || len(path) != 0 {
+			t.Errorf("%+v: LeastHeatLoss() of one block = %d, %v, %v, want 0, [], true", c, heatLoss, path, ok)
+		}
+	}
+}
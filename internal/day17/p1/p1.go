@@ -0,0 +1,29 @@
+package p1
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day17"
+)
+
+// crucible can go at most three blocks in a straight line.
+var crucible = day17.Crucible{MinRun: 1, MaxRun: 3}
+
+func Solve(r io.Reader) (string, error) {
+
+	city, err := day17.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, path, ok := crucible.LeastHeatLoss(city)
+	if !ok {
+		return "", errors.New("the crucible can't reach the factory")
+	}
+	aoc.Debugf("%s", day17.Overlay(city, path))
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,30 @@
+package p2
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day17"
+)
+
+// crucible is an ultra crucible, which must go at least four blocks in a
+// straight line and may go up to ten.
+var crucible = day17.Crucible{MinRun: 4, MaxRun: 10}
+
+func Solve(r io.Reader) (string, error) {
+
+	city, err := day17.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, path, ok := crucible.LeastHeatLoss(city)
+	if !ok {
+		return "", errors.New("the crucible can't reach the factory")
+	}
+	aoc.Debugf("%s", day17.Overlay(city, path))
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
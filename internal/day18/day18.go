@@ -0,0 +1,95 @@
+// Package day18 holds the dig plan reading and lagoon measuring shared by both
+// parts of day 18.
+package day18
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Instruction digs Dist metres of trench in Dir.
+type Instruction struct {
+	Dir  grid.Vector
+	Dist int
+}
+
+var letterDirs = map[string]grid.Vector{
+	"U": grid.UpVec,
+	"R": grid.RightVec,
+	"D": grid.DownVec,
+	"L": grid.LeftVec,
+}
+
+// hexDirs are the directions the last digit of a colour code stands for.
+var hexDirs = []grid.Vector{grid.RightVec, grid.DownVec, grid.LeftVec, grid.UpVec}
+
+// Parse reads the dig plan, returning each line's instruction as written and
+// as decoded from the colour code alongside it.
+func Parse(r io.Reader) (written, decoded []Instruction, err error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, line := range lines {
+		w, d, err := parseInstruction(line)
+		if err != nil {
+			return nil, nil, parse.AtLine(err, i+1, line)
+		}
+		written = append(written, w)
+		decoded = append(decoded, d)
+	}
+	return written, decoded, nil
+}
+
+func parseInstruction(line string) (written, decoded Instruction, err error) {
+	var dir, colour parse.Field
+	if err := parse.Scanf(line, "{word} {int} (#{word})", &dir, &written.Dist, &colour); err != nil {
+		return written, decoded, err
+	}
+	var ok bool
+	if written.Dir, ok = letterDirs[dir.Text]; !ok {
+		return written, decoded, dir.Expected("a direction (one of UDLR)", nil)
+	}
+
+	if len(colour.Text) != 6 {
+		return written, decoded, colour.Expected("a six digit colour code", nil)
+	}
+	dist, err := strconv.ParseUint(colour.Text[:5], 16, 32)
+	if err != nil {
+		return written, decoded, colour.Slice(0, 5).Expected("a hex distance", err)
+	}
+	last := colour.Text[5] - '0'
+	if int(last) >= len(hexDirs) {
+		return written, decoded, colour.Slice(5, 6).Expected("a direction digit from 0 to 3", nil)
+	}
+	decoded = Instruction{Dir: hexDirs[last], Dist: int(dist)}
+	return written, decoded, nil
+}
+
+// Trench returns the corners of the trench plan digs, starting from the
+// origin, or false when the trench doesn't return there.
+func Trench(plan []Instruction) (grid.Polygon, bool) {
+	corners := make(grid.Polygon, 0, len(plan))
+	pos := grid.Vector{}
+	for _, in := range plan {
+		corners = append(corners, pos)
+		pos = pos.Add(in.Dir.Scale(in.Dist))
+	}
+	return corners, pos == grid.Vector{}
+}
+
+// Lagoon returns how many cubic metres the lagoon holds once the trench plan
+// digs is dug out along with everything inside it.
+func Lagoon(plan []Instruction) (int, error) {
+	trench, ok := Trench(plan)
+	if !ok {
+		return 0, errors.New("the trench doesn't return to where it started")
+	}
+	aoc.Debugf("Trench of %d corners: %d on the edge, %d inside\n", len(trench), trench.Boundary(), trench.Interior())
+	return trench.Interior() + trench.Boundary(), nil
+}
@@ -0,0 +1,69 @@
+package day18
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	written, decoded, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The puzzle text decodes the colour codes of the first few lines.
+	wantWritten := []Instruction{{grid.RightVec, 6}, {grid.DownVec, 5}, {grid.LeftVec, 2}}
+	wantDecoded := []Instruction{{grid.RightVec, 461937}, {grid.DownVec, 56407}, {grid.RightVec, 356671}}
+	for i := range wantWritten {
+		if written[i] != wantWritten[i] || decoded[i] != wantDecoded[i] {
+			t.Errorf("line %d = %v, %v, want %v, %v", i+1, written[i], decoded[i], wantWritten[i], wantDecoded[i])
+		}
+	}
+
+	for _, plan := range [][]Instruction{written, decoded} {
+		trench, ok := Trench(plan)
+		if !ok || len(trench) != len(plan) {
+			t.Errorf("Trench() = %v, %v, want a closed trench of %d corners", trench, ok, len(plan))
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"X 6 (#70c710)", 1},
+		{"R six (#70c710)", 3},
+		{"R 6 (#70c71)", 7},
+		{"R 6 (#70g710)", 7},
+		{"R 6 (#70c714)", 12},
+	}
+	for _, tt := range tests {
+		_, _, err := parseInstruction(tt.line)
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Column != tt.column {
+			t.Errorf("parseInstruction(%q) = %v, want a ParseError at column %d", tt.line, err, tt.column)
+		}
+	}
+}
+
+func TestLagoon(t *testing.T) {
+	square := []Instruction{{grid.RightVec, 2}, {grid.DownVec, 2}, {grid.LeftVec, 2}, {grid.UpVec, 2}}
+	if got, err := Lagoon(square); err != nil || got != 9 {
+		t.Errorf("Lagoon(2x2 square) = %d, %v, want 9", got, err)
+	}
+	if _, err := Lagoon(square[:3]); err == nil {
+		t.Error("Lagoon() of an open trench succeeded")
+	}
+}
@@ -0,0 +1,24 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day18"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	plan, _, err := day18.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, err := day18.Lagoon(plan)
+	if err != nil {
+		return "", err
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
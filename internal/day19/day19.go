@@ -0,0 +1,229 @@
+// Package day19 holds the workflow parsing and evaluation shared by both parts
+// of day 19.
+package day19
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/interval"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Categories are the letters of the four ratings of a part, in the order a
+// Part holds them.
+const Categories = "xmas"
+
+// Start is the workflow every part begins at, and Accepted and Rejected the
+// two places it can end up.
+const (
+	Start    = "in"
+	Accepted = "A"
+	Rejected = "R"
+)
+
+// Part is a machine part's rating in each of the Categories.
+type Part [4]int
+
+// Total is the sum of p's ratings.
+func (p Part) Total() int {
+	return p[0] + p[1] + p[2] + p[3]
+}
+
+func (p Part) String() string {
+	return fmt.Sprintf("{x=%d,m=%d,a=%d,s=%d}", p[0], p[1], p[2], p[3])
+}
+
+// Rule sends a part to Target when its rating in Category is less than (Op
+// '<') or greater than (Op '>') Value. A rule with no Op sends every part.
+type Rule struct {
+	Category int
+	Op       byte
+	Value    int
+	Target   string
+}
+
+// Workflow is a list of rules, tried in order. Its last rule has no Op.
+type Workflow []Rule
+
+// System is the workflows, by name, and the parts to sort with them.
+type System struct {
+	Workflows map[string]Workflow
+	Parts     []Part
+}
+
+// Parse reads the workflows and then, after a blank line, the parts.
+func Parse(r io.Reader) (*System, error) {
+	paragraphs, err := parse.Paragraphs(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(paragraphs) != 2 {
+		return nil, fmt.Errorf("got %d blocks of lines, want workflows and parts", len(paragraphs))
+	}
+
+	s := &System{Workflows: make(map[string]Workflow)}
+	workflows, parts := paragraphs[0], paragraphs[1]
+	for i, line := range workflows.Lines {
+		name, w, err := parseWorkflow(line)
+		if err != nil {
+			return nil, parse.AtLine(err, workflows.Line+i, line)
+		}
+		s.Workflows[name] = w
+	}
+	for i, line := range parts.Lines {
+		var p Part
+		if err := parse.Scanf(line, "{x={int},m={int},a={int},s={int}}", &p[0], &p[1], &p[2], &p[3]); err != nil {
+			return nil, parse.AtLine(err, parts.Line+i, line)
+		}
+		s.Parts = append(s.Parts, p)
+	}
+
+	if _, ok := s.Workflows[Start]; !ok {
+		return nil, fmt.Errorf("no %q workflow", Start)
+	}
+	for name, w := range s.Workflows {
+		for _, rule := range w {
+			if _, ok := s.Workflows[rule.Target]; !ok && rule.Target != Accepted && rule.Target != Rejected {
+				return nil, fmt.Errorf("workflow %s sends parts to %s, which doesn't exist", name, rule.Target)
+			}
+		}
+	}
+	return s, nil
+}
+
+func parseWorkflow(line string) (string, Workflow, error) {
+	f := parse.Line(line)
+	open := strings.IndexByte(line, '{')
+	if open < 1 || !strings.HasSuffix(line, "}") {
+		return "", nil, f.Expected("a workflow like name{rule,...}", nil)
+	}
+
+	var w Workflow
+	rules := f.Slice(open+1, len(line)-1).Split(",")
+	for i, rf := range rules {
+		var rule Rule
+		cond, target, ok := strings.Cut(rf.Text, ":")
+		if !ok {
+			if i != len(rules)-1 {
+				return "", nil, rf.Expected("a condition like a<2006:target", nil)
+			}
+			rule.Target = rf.Text
+			w = append(w, rule)
+			break
+		}
+		if i == len(rules)-1 {
+			return "", nil, rf.Expected("a last rule without a condition", nil)
+		}
+
+		if len(cond) < 3 || strings.IndexByte(Categories, cond[0]) < 0 {
+			return "", nil, rf.Slice(0, min(1, len(cond))).Expected("a category (one of xmas)", nil)
+		}
+		rule.Category = strings.IndexByte(Categories, cond[0])
+		if rule.Op = cond[1]; rule.Op != '<' && rule.Op != '>' {
+			return "", nil, rf.Slice(1, 2).Expected("< or >", nil)
+		}
+		value, err := rf.Slice(2, len(cond)).Int()
+		if err != nil {
+			return "", nil, err
+		}
+		rule.Value = value
+		rule.Target = target
+		w = append(w, rule)
+	}
+	return line[:open], w, nil
+}
+
+// Route runs p through the workflows from Start, returning the names of the
+// workflows it passes through and whether it ends up accepted.
+func (s *System) Route(p Part) ([]string, bool, error) {
+	path := []string{Start}
+	for name := Start; ; {
+		if len(path) > len(s.Workflows)+1 {
+			return nil, false, fmt.Errorf("%v goes round in circles: %s", p, strings.Join(path, " -> "))
+		}
+		for _, rule := range s.Workflows[name] {
+			if rule.Matches(p[rule.Category]) {
+				name = rule.Target
+				break
+			}
+		}
+		path = append(path, name)
+		if name == Accepted || name == Rejected {
+			return path, name == Accepted, nil
+		}
+	}
+}
+
+// Matches reports whether a part rated rating in the rule's category is sent
+// to its target.
+func (r Rule) Matches(rating int) bool {
+	switch r.Op {
+	case '<':
+		return rating < r.Value
+	case '>':
+		return rating > r.Value
+	}
+	return true
+}
+
+// Ratings is a range of ratings in each of the Categories, standing for every
+// part with ratings in all of them.
+type Ratings [4]interval.Interval[int]
+
+// Combinations returns how many distinct parts r stands for.
+func (r Ratings) Combinations() int {
+	n := 1
+	for _, iv := range r {
+		n *= iv.Len()
+	}
+	return n
+}
+
+// split divides r into the parts the rule sends to its target and the rest.
+func (rule Rule) split(r Ratings) (matched, rest Ratings) {
+	matched, rest = r, r
+	iv := r[rule.Category]
+	switch rule.Op {
+	case '<':
+		matched[rule.Category] = iv.Intersect(interval.Interval[int]{Start: iv.Start, End: rule.Value})
+		rest[rule.Category] = iv.Intersect(interval.Interval[int]{Start: rule.Value, End: iv.End})
+	case '>':
+		matched[rule.Category] = iv.Intersect(interval.Interval[int]{Start: rule.Value + 1, End: iv.End})
+		rest[rule.Category] = iv.Intersect(interval.Interval[int]{Start: iv.Start, End: rule.Value + 1})
+	default:
+		rest = Ratings{}
+	}
+	return matched, rest
+}
+
+// AcceptedCombinations returns how many of the parts r stands for are
+// accepted, splitting r at each rule rather than trying every part.
+func (s *System) AcceptedCombinations(r Ratings) (int, error) {
+	return s.accepted(Start, r, 0)
+}
+
+func (s *System) accepted(name string, r Ratings, depth int) (int, error) {
+	switch {
+	case r.Combinations() == 0 || name == Rejected:
+		return 0, nil
+	case name == Accepted:
+		return r.Combinations(), nil
+	case depth > len(s.Workflows):
+		return 0, errors.New("the workflows go round in circles")
+	}
+
+	total := 0
+	for _, rule := range s.Workflows[name] {
+		var matched Ratings
+		matched, r = rule.split(r)
+		n, err := s.accepted(rule.Target, matched, depth+1)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	return total, nil
+}
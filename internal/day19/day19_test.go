@@ -0,0 +1,73 @@
+package day19
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+func mustParse(t *testing.T, input string) *System {
+	t.Helper()
+	s, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// full is every part there could be.
+var full = Ratings{{Start: 1, End: 4001}, {Start: 1, End: 4001}, {Start: 1, End: 4001}, {Start: 1, End: 4001}}
+
+func TestAcceptedCombinations(t *testing.T) {
+	s := mustParse(t, "in{x<11:lo,m>3990:A,R}\nlo{a>5:R,A}\n\n{x=1,m=1,a=1,s=1}\n")
+
+	// x from 1 to 10 with a from 1 to 5, or x above 10 with m above 3990.
+	want := 10*4000*5*4000 + 3990*10*4000*4000
+	got, err := s.AcceptedCombinations(full)
+	if err != nil || got != want {
+		t.Errorf("AcceptedCombinations() = %d, %v, want %d", got, err, want)
+	}
+
+	path, accepted, err := s.Route(s.Parts[0])
+	if err != nil || !accepted || strings.Join(path, " ") != "in lo A" {
+		t.Errorf("Route(%v) = %v, %v, %v, want accepted via in lo A", s.Parts[0], path, accepted, err)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	s := mustParse(t, "in{x<11:a,R}\na{m>5:in,A}\n\n{x=1,m=9,a=1,s=1}\n")
+	if _, _, err := s.Route(s.Parts[0]); err == nil {
+		t.Error("Route() of a part going round in circles succeeded")
+	}
+	if _, err := s.AcceptedCombinations(full); err == nil {
+		t.Error("AcceptedCombinations() of workflows going round in circles succeeded")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input        string
+		line, column int
+	}{
+		{"in{x<11:A,R\n\n{x=1,m=1,a=1,s=1}\n", 1, 1},
+		{"in{q<11:A,R}\n\n{x=1,m=1,a=1,s=1}\n", 1, 4},
+		{"in{x=11:A,R}\n\n{x=1,m=1,a=1,s=1}\n", 1, 5},
+		{"in{x<1a:A,R}\n\n{x=1,m=1,a=1,s=1}\n", 1, 6},
+		{"in{x<11:A,R,A}\n\n{x=1,m=1,a=1,s=1}\n", 1, 11},
+		{"in{x<11:A,m>1:R}\n\n{x=1,m=1,a=1,s=1}\n", 1, 11},
+		{"in{x<11:A,R}\n\n{x=1,m=1,a=1,s=1}\n{x=1,m=1,s=1}\n", 4, 1},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Column != tt.column {
+			t.Errorf("Parse(%q) = %v, want a ParseError at %d:%d", tt.input, err, tt.line, tt.column)
+		}
+	}
+
+	if _, err := Parse(strings.NewReader("in{x<11:qq,R}\n\n{x=1,m=1,a=1,s=1}\n")); err == nil {
+		t.Error("Parse() with a missing workflow succeeded")
+	}
+}
@@ -0,0 +1,33 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day19"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	system, err := day19.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := 0
+	for _, part := range system.Parts {
+		path, accepted, err := system.Route(part)
+		if err != nil {
+			return "", err
+		}
+		aoc.Debugf("%v: %s\n", part, strings.Join(path, " -> "))
+		if accepted {
+			score += part.Total()
+		}
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
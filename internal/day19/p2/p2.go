@@ -0,0 +1,33 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day19"
+)
+
+// allRatings is every part there could be: each rating is from 1 to 4000.
+var allRatings = day19.Ratings{
+	{Start: 1, End: 4001},
+	{Start: 1, End: 4001},
+	{Start: 1, End: 4001},
+	{Start: 1, End: 4001},
+}
+
+func Solve(r io.Reader) (string, error) {
+
+	system, err := day19.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, err := system.AcceptedCombinations(allRatings)
+	if err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,184 @@
+// Package day20 holds the pulse propagation simulator shared by both parts of
+// day 20.
+package day20
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/arith"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Button and Broadcaster are the names of the button and the module it
+// sends a low pulse to when pushed.
+const (
+	Button      = "button"
+	Broadcaster = "broadcaster"
+)
+
+type Kind byte
+
+const (
+	// Untyped modules, only named as another module's output, do nothing.
+	Untyped Kind = iota
+	// Broadcast modules pass on every pulse they receive.
+	Broadcast
+	// FlipFlop modules ignore high pulses and flip on or off on a low one,
+	// sending high if they turned on and low if they turned off.
+	FlipFlop
+	// Conjunction modules remember the last pulse from each of their inputs,
+	// and send low when they are all high and high otherwise.
+	Conjunction
+)
+
+// Module is a module in the network, and its state.
+type Module struct {
+	Name    string
+	Kind    Kind
+	Outputs []string
+	// Inputs are the modules with this one as an output.
+	Inputs []string
+
+	on     bool
+	memory map[string]bool
+}
+
+// Pulse is a pulse sent from one module to another.
+type Pulse struct {
+	From, To string
+	High     bool
+}
+
+func (p Pulse) String() string {
+	level := "low"
+	if p.High {
+		level = "high"
+	}
+	return fmt.Sprintf("%s -%s-> %s", p.From, level, p.To)
+}
+
+// Network is the modules, by name.
+type Network map[string]*Module
+
+// Parse reads the modules and their outputs, and connects them up.
+func Parse(r io.Reader) (Network, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	n := Network{}
+	var parsed []*Module
+	for i, line := range lines {
+		var name, outputs parse.Field
+		if err := parse.Scanf(line, "{word} -> {rest}", &name, &outputs); err != nil {
+			return nil, parse.AtLine(err, i+1, line)
+		}
+		m := &Module{Name: name.Text, Kind: Broadcast}
+		switch {
+		case strings.HasPrefix(name.Text, "%"):
+			m.Kind, m.Name = FlipFlop, name.Text[1:]
+		case strings.HasPrefix(name.Text, "&"):
+			m.Kind, m.Name = Conjunction, name.Text[1:]
+			m.memory = map[string]bool{}
+		case name.Text != Broadcaster:
+			return nil, parse.AtLine(name.Expected("a module (%name, &name or broadcaster)", nil), i+1, line)
+		}
+		for _, out := range outputs.Split(",") {
+			if out.Text == "" {
+				return nil, parse.AtLine(out.Expected("a module name", nil), i+1, line)
+			}
+			m.Outputs = append(m.Outputs, out.Text)
+		}
+		n[m.Name] = m
+		parsed = append(parsed, m)
+	}
+	if _, ok := n[Broadcaster]; !ok {
+		return nil, errors.New("no broadcaster module")
+	}
+
+	for _, m := range parsed {
+		for _, out := range m.Outputs {
+			if n[out] == nil {
+				n[out] = &Module{Name: out}
+			}
+			n[out].Inputs = append(n[out].Inputs, m.Name)
+		}
+	}
+	return n, nil
+}
+
+// Press pushes the button once, calling observe with every pulse sent, in the
+// order they are sent, until the network settles.
+func (n Network) Press(observe func(Pulse)) {
+	queue := []Pulse{{From: Button, To: Broadcaster}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		observe(p)
+
+		m := n[p.To]
+		var high bool
+		switch m.Kind {
+		case Untyped:
+			continue
+		case Broadcast:
+			high = p.High
+		case FlipFlop:
+			if p.High {
+				continue
+			}
+			m.on = !m.on
+			high = m.on
+		case Conjunction:
+			m.memory[p.From] = p.High
+			high = false
+			for _, in := range m.Inputs {
+				if !m.memory[in] {
+					high = true
+					break
+				}
+			}
+		}
+		for _, out := range m.Outputs {
+			queue = append(queue, Pulse{From: m.Name, To: out, High: high})
+		}
+	}
+}
+
+// PressesUntilLow returns how many times the button must be pushed before
+// target is sent a low pulse, starting from a freshly parsed network and
+// giving up after maxPresses.
+//
+// Simulating that many pushes would take far too long. Instead this relies on
+// the shape of the puzzle input: target's only input is a conjunction, each of
+// whose inputs sends it a high pulse on pushes that are whole multiples of its
+// own period. The conjunction sends low when they all do so on the same push,
+// which is the least common multiple of the periods.
+func (n Network) PressesUntilLow(target string, maxPresses int) (int, error) {
+	m, ok := n[target]
+	if !ok || len(m.Inputs) != 1 || n[m.Inputs[0]].Kind != Conjunction {
+		return 0, fmt.Errorf("%s isn't fed by a single conjunction module", target)
+	}
+	hub := n[m.Inputs[0]]
+
+	periods := map[string]int{}
+	for presses := 1; presses <= maxPresses; presses++ {
+		n.Press(func(p Pulse) {
+			if _, seen := periods[p.From]; p.To == hub.Name && p.High && !seen {
+				periods[p.From] = presses
+			}
+		})
+		if len(periods) == len(hub.Inputs) {
+			lengths := make([]int, 0, len(periods))
+			for _, in := range hub.Inputs {
+				lengths = append(lengths, periods[in])
+			}
+			return arith.LCM(lengths...), nil
+		}
+	}
+	return 0, fmt.Errorf("only %d of the %d inputs of %s sent a high pulse in %d pushes", len(periods), len(hub.Inputs), hub.Name, maxPresses)
+}
@@ -0,0 +1,79 @@
+package day20
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, path string) Network {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestPress(t *testing.T) {
+	n := mustParse(t, filepath.Join("p1", "testdata", "example.txt"))
+	var got []string
+	n.Press(func(p Pulse) {
+		got = append(got, p.String())
+	})
+
+	// The pulses the puzzle text lists for the first push.
+	want := []string{
+		"button -low-> broadcaster",
+		"broadcaster -low-> a",
+		"broadcaster -low-> b",
+		"broadcaster -low-> c",
+		"a -high-> b",
+		"b -high-> c",
+		"c -high-> inv",
+		"inv -low-> a",
+		"a -low-> b",
+		"b -low-> c",
+		"c -low-> inv",
+		"inv -high-> a",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Press() sent\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestPressesUntilLow(t *testing.T) {
+	path := filepath.Join("p2", "testdata", "example.txt")
+	got, err := mustParse(t, path).PressesUntilLow("rx", 1<<16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Push the button that many times for real to check the shortcut.
+	n := mustParse(t, path)
+	want := 0
+	for presses := 1; want == 0; presses++ {
+		if presses > 1<<16 {
+			t.Fatal("rx was never sent a low pulse")
+		}
+		n.Press(func(p Pulse) {
+			if p.To == "rx" && !p.High && want == 0 {
+				want = presses
+			}
+		})
+	}
+	if got != want {
+		t.Errorf("PressesUntilLow() = %d, but rx is first sent low on push %d", got, want)
+	}
+
+	if _, err := mustParse(t, filepath.Join("p1", "testdata", "example.txt")).PressesUntilLow("a", 100); err == nil {
+		t.Error("PressesUntilLow() of a module not fed by a conjunction succeeded")
+	}
+}
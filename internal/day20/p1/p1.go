@@ -0,0 +1,39 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day20"
+)
+
+// presses is how many times the button is pushed.
+const presses = 1000
+
+func Solve(r io.Reader) (string, error) {
+
+	network, err := day20.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	low, high := 0, 0
+	for i := 0; i < presses; i++ {
+		network.Press(func(p day20.Pulse) {
+			if i == 0 {
+				aoc.Debugf("%v\n", p)
+			}
+			if p.High {
+				high++
+			} else {
+				low++
+			}
+		})
+	}
+	aoc.Debugf("%d low pulses, %d high pulses\n", low, high)
+
+	score := low * high
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,32 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day20"
+)
+
+// machine is the module that turns on when it is sent a low pulse.
+const machine = "rx"
+
+// maxPresses is far more than the periods of a puzzle input's counters, which
+// are all below 4096.
+const maxPresses = 1 << 16
+
+func Solve(r io.Reader) (string, error) {
+
+	network, err := day20.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, err := network.PressesUntilLow(machine, maxPresses)
+	if err != nil {
+		return "", err
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
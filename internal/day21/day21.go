@@ -0,0 +1,130 @@
+// Package day21 holds the garden walking shared by both parts of day 21.
+package day21
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/arith"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// Garden is a map of garden plots, where true is a rock, and where the elf
+// starts.
+type Garden struct {
+	Rocks *grid.Grid[bool]
+	Start grid.Vector
+}
+
+// Parse reads a map of garden plots (.) and rocks (#), with the elf's starting
+// plot marked S.
+func Parse(r io.Reader) (Garden, error) {
+	plots, err := grid.ParseFunc(r, func(c byte) (byte, error) {
+		switch c {
+		case '.', '#', 'S':
+			return c, nil
+		}
+		return 0, &aoc.ParseError{Expected: "one of .#S"}
+	})
+	if err != nil {
+		return Garden{}, err
+	}
+
+	g := Garden{Rocks: grid.New[bool](plots.Width(), plots.Height())}
+	starts := 0
+	plots.Each(func(v grid.Vector, c byte) {
+		g.Rocks.Set(v, c == '#')
+		if c == 'S' {
+			g.Start = v
+			starts++
+		}
+	})
+	if starts != 1 {
+		return Garden{}, fmt.Errorf("found %d starting plots S, want 1", starts)
+	}
+	return g, nil
+}
+
+// rock reports whether there is a rock at v, on a map tiled infinitely in
+// every direction when tiled is true. Off an untiled map is all rock.
+func (g Garden) rock(v grid.Vector, tiled bool) bool {
+	if tiled {
+		w, h := g.Rocks.Width(), g.Rocks.Height()
+		v = grid.Vector{X: (v.X%w + w) % w, Y: (v.Y%h + h) % h}
+	}
+	return g.Rocks.GetOr(v, true)
+}
+
+// Reachable returns how many plots the elf can end up on after each of steps
+// steps, on a map tiled infinitely in every direction when tiled is true.
+//
+// The elf can step back and forth between two plots, so can end on any plot
+// reachable in at most that many steps an even number of steps short of it.
+// One breadth first search out to the largest of steps answers all of them.
+func (g Garden) Reachable(tiled bool, steps ...int) []int {
+	limit := slices.Max(steps)
+	// byParity counts the plots first reached in an even and an odd number
+	// of steps, up to the steps searched so far.
+	var byParity [2]int
+	counts := make([]int, len(steps))
+	record := func(dist int) {
+		for i, s := range steps {
+			if s == dist {
+				counts[i] = byParity[s%2]
+			}
+		}
+	}
+
+	seen := map[grid.Vector]bool{g.Start: true}
+	frontier := []grid.Vector{g.Start}
+	dist := 0
+	for ; dist <= limit && len(frontier) > 0; dist++ {
+		byParity[dist%2] += len(frontier)
+		record(dist)
+
+		var next []grid.Vector
+		for _, pos := range frontier {
+			for _, dir := range grid.AllDirections {
+				n := pos.Add(dir)
+				if !seen[n] && !g.rock(n, tiled) {
+					seen[n] = true
+					next = append(next, n)
+				}
+			}
+		}
+		frontier = next
+	}
+	// Everything reachable has been found, so longer walks reach no more.
+	for ; dist <= limit; dist++ {
+		record(dist)
+	}
+	return counts
+}
+
+// ReachableFar returns how many plots the elf can end up on after steps steps
+// on the infinitely tiled map, for steps too many to search.
+//
+// It relies on the shape of the puzzle input: a square garden with the elf in
+// the middle of it and nothing in the elf's row or column or around the edge
+// to slow it down, and steps taking the elf exactly to the edge of a distant
+// copy of the garden. The reachable area is then a diamond whose size grows by
+// a garden width every garden width of steps, so the count is a quadratic in
+// how many gardens out the elf gets. Three searches fit the quadratic.
+func (g Garden) ReachableFar(steps int) (int, error) {
+	size := g.Rocks.Width()
+	half := size / 2
+	if g.Rocks.Height() != size || g.Start != (grid.Vector{X: half, Y: half}) {
+		return 0, errors.New("the elf doesn't start in the middle of a square garden")
+	}
+	if steps < half || (steps-half)%size != 0 {
+		return 0, fmt.Errorf("%d steps don't end at the edge of a copy of a %d wide garden", steps, size)
+	}
+
+	samples := g.Reachable(true, half, half+size, half+2*size)
+	gardens := (steps - half) / size
+	aoc.Debugf("Reachable after %d, %d and %d steps: %v\n", half, half+size, half+2*size, samples)
+	return arith.ExtrapolateTo(samples, gardens), nil
+}
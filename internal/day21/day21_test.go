@@ -0,0 +1,147 @@
+package day21
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// walk counts the plots the elf can end up on after steps steps the slow way,
+// moving every plot it could be on a step at a time.
+func walk(g Garden, steps int) int {
+	at := map[grid.Vector]bool{g.Start: true}
+	for i := 0; i < steps; i++ {
+		next := map[grid.Vector]bool{}
+		for pos := range at {
+			for _, dir := range grid.AllDirections {
+				if n := pos.Add(dir); !g.rock(n, false) {
+					next[n] = true
+				}
+			}
+		}
+		at = next
+	}
+	return len(at)
+}
+
+// streetsReachable counts the plots the elf can end up on after steps steps
+// on part 2's example, tiled: streets along every third row and column, with
+// the elf starting where two cross. Any plot can be reached along the elf's
+// row or column and then its own street, so it is as many steps away as its
+// Manhattan distance, and the elf can end on it when that is at most steps
+// and of the same parity.
+func streetsReachable(steps int) int {
+	count := 0
+	for x := -steps; x <= steps; x++ {
+		// ys are the rows y with |x| + |y| <= steps of the right parity:
+		// left = steps - |x|, left - 2, ..., -left.
+		left := steps - max(x, -x)
+		if x%3 == 0 {
+			count += left + 1
+			continue
+		}
+		// Off a column street, only the rows on a street count: left - 2j
+		// divisible by 3, which is j = 2*left mod 3, 3 apart.
+		if first := 2 * left % 3; first <= left {
+			count += (left-first)/3 + 1
+		}
+	}
+	return count
+}
+
+func TestReachable(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	garden, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := garden.Reachable(false, 6); got[0] != 16 {
+		t.Errorf("Reachable(6) = %d, want 16", got[0])
+	}
+	// The puzzle text doesn't count the plots after part 1's 64 steps, so
+	// check the golden answer by walking there the slow way.
+	if got, walked := garden.Reachable(false, 64)[0], walk(garden, 64); got != 42 || walked != 42 {
+		t.Errorf("Reachable(64) = %d and walking gives %d, want 42", got, walked)
+	}
+	// The counts the puzzle text gives on the infinite map.
+	steps := []int{6, 10, 50, 100, 500}
+	want := []int{16, 50, 1594, 6536, 167004}
+	if got := garden.Reachable(true, steps...); !reflect.DeepEqual(got, want) {
+		t.Errorf("Reachable(tiled, %v) = %v, want %v", steps, got, want)
+	}
+
+	// Boxed in, the elf runs out of new plots long before 100 steps.
+	boxed, err := Parse(strings.NewReader("#####\n#..##\n#.S.#\n#####\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := boxed.Reachable(false, 99, 100); !reflect.DeepEqual(got, []int{3, 2}) {
+		t.Errorf("Reachable(boxed, 99, 100) = %v, want [3 2]", got)
+	}
+}
+
+func TestReachableFar(t *testing.T) {
+	// Like the puzzle input, the elf's row and column and the edges are clear,
+	// and the rocks are few and symmetric.
+	garden, err := Parse(strings.NewReader(strings.Join([]string{
+		"...........",
+		".#.......#.",
+		"...#...#...",
+		"...........",
+		".#.......#.",
+		".....S.....",
+		".#.......#.",
+		"...........",
+		"...#...#...",
+		".#.......#.",
+		"...........",
+	}, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for gardens := 3; gardens <= 6; gardens++ {
+		steps := 5 + 11*gardens
+		got, err := garden.ReachableFar(steps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := garden.Reachable(true, steps)[0]; got != want {
+			t.Errorf("ReachableFar(%d) = %d, want %d", steps, got, want)
+		}
+	}
+
+	// testdata/example.txt of part 2.
+	streets, err := Parse(strings.NewReader("#.#\n.S.\n#.#\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for steps := 1; steps < 100; steps += 3 {
+		got, err := streets.ReachableFar(steps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := streets.Reachable(true, steps)[0]; got != want {
+			t.Errorf("ReachableFar(%d) of the streets = %d, want %d", steps, got, want)
+		}
+	}
+
+	// Part 2's golden answer, counted without searching.
+	const far = 26501365
+	if got, err := streets.ReachableFar(far); err != nil || got != streetsReachable(far) {
+		t.Errorf("ReachableFar(%d) of the streets = %d, %v, want %d", far, got, err, streetsReachable(far))
+	}
+
+	if _, err := garden.ReachableFar(100); err == nil {
+		t.Error("ReachableFar() of steps ending inside a garden succeeded")
+	}
+}
@@ -0,0 +1,24 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day21"
+)
+
+// steps is how far the elf walks today.
+const steps = 64
+
+func Solve(r io.Reader) (string, error) {
+
+	garden, err := day21.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := garden.Reachable(false, steps)[0]
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
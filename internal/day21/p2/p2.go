@@ -0,0 +1,27 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day21"
+)
+
+// steps is how far the elf really needs to walk, on the infinite map.
+const steps = 26501365
+
+func Solve(r io.Reader) (string, error) {
+
+	garden, err := day21.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score, err := garden.ReachableFar(steps)
+	if err != nil {
+		return "", err
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
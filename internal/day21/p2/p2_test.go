@@ -0,0 +1,28 @@
+package p2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	// The puzzle's example isn't shaped like its input, so part 2 can't solve
+	// it. testdata/example.txt is a made up garden that is shaped like one,
+	// and simple enough that day21_test.go counts want without searching.
+	const want = "390179122815028"
+
+	f, err := os.Open(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	got, err := Solve(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Solve(example.txt) = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,198 @@
+// Package day22 holds the brick settling shared by both parts of day 22.
+package day22
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+// Point is a cube's position in the snapshot. Z is height above the ground,
+// which is at 0.
+type Point struct {
+	X, Y, Z int
+}
+
+// Brick is the cubes from Min to Max inclusive, which differ in at most one
+// coordinate.
+type Brick struct {
+	Min, Max Point
+}
+
+func (b Brick) String() string {
+	return fmt.Sprintf("%d,%d,%d~%d,%d,%d", b.Min.X, b.Min.Y, b.Min.Z, b.Max.X, b.Max.Y, b.Max.Z)
+}
+
+// Cubes returns the positions of b's cubes.
+func (b Brick) Cubes() []Point {
+	var cubes []Point
+	for x := b.Min.X; x <= b.Max.X; x++ {
+		for y := b.Min.Y; y <= b.Max.Y; y++ {
+			for z := b.Min.Z; z <= b.Max.Z; z++ {
+				cubes = append(cubes, Point{X: x, Y: y, Z: z})
+			}
+		}
+	}
+	return cubes
+}
+
+// Parse reads a snapshot of falling bricks, one per line as the coordinates of
+// its two ends.
+func Parse(r io.Reader) ([]Brick, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var bricks []Brick
+	for i, line := range lines {
+		var a, b Point
+		if err := parse.Scanf(line, "{int},{int},{int}~{int},{int},{int}", &a.X, &a.Y, &a.Z, &b.X, &b.Y, &b.Z); err != nil {
+			return nil, parse.AtLine(err, i+1, line)
+		}
+		brick := Brick{
+			Min: Point{X: min(a.X, b.X), Y: min(a.Y, b.Y), Z: min(a.Z, b.Z)},
+			Max: Point{X: max(a.X, b.X), Y: max(a.Y, b.Y), Z: max(a.Z, b.Z)},
+		}
+		if brick.Min.Z < 1 {
+			return nil, parse.AtLine(parse.Line(line).Expected("a brick above the ground", nil), i+1, line)
+		}
+		bricks = append(bricks, brick)
+	}
+	return bricks, nil
+}
+
+// Stack is the bricks once they have all fallen as far as they can.
+type Stack struct {
+	// Bricks are the settled bricks, lowest first.
+	Bricks []Brick
+	// Supports and SupportedBy hold, for each brick, the indexes of the
+	// bricks resting directly on it and of those it rests directly on.
+	Supports, SupportedBy [][]int
+}
+
+// Settle lets bricks fall until each rests on the ground or another brick.
+func Settle(bricks []Brick) *Stack {
+	s := &Stack{
+		Bricks:      slices.Clone(bricks),
+		Supports:    make([][]int, len(bricks)),
+		SupportedBy: make([][]int, len(bricks)),
+	}
+	slices.SortStableFunc(s.Bricks, func(a, b Brick) int {
+		return cmp.Compare(a.Min.Z, b.Min.Z)
+	})
+
+	// top is the highest brick over each column of the ground, by index into
+	// s.Bricks; columns with none aren't in it.
+	top := map[grid.Vector]int{}
+	for i, b := range s.Bricks {
+		floor := 0
+		for x := b.Min.X; x <= b.Max.X; x++ {
+			for y := b.Min.Y; y <= b.Max.Y; y++ {
+				if under, ok := top[grid.Vector{X: x, Y: y}]; ok {
+					floor = max(floor, s.Bricks[under].Max.Z)
+				}
+			}
+		}
+
+		drop := b.Min.Z - floor - 1
+		b.Min.Z -= drop
+		b.Max.Z -= drop
+		s.Bricks[i] = b
+		for x := b.Min.X; x <= b.Max.X; x++ {
+			for y := b.Min.Y; y <= b.Max.Y; y++ {
+				v := grid.Vector{X: x, Y: y}
+				if under, ok := top[v]; ok && s.Bricks[under].Max.Z == floor && !slices.Contains(s.SupportedBy[i], under) {
+					s.SupportedBy[i] = append(s.SupportedBy[i], under)
+					s.Supports[under] = append(s.Supports[under], i)
+				}
+				top[v] = i
+			}
+		}
+	}
+	return s
+}
+
+// Disintegrable reports whether brick i can go without any other brick
+// falling, because everything it supports has another support too.
+func (s *Stack) Disintegrable(i int) bool {
+	for _, above := range s.Supports[i] {
+		if len(s.SupportedBy[above]) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// ChainReaction returns how many other bricks fall when brick i is
+// disintegrated. Bricks only rest on lower ones, so going through them in
+// order from i up finds every brick whose supports have all gone.
+func (s *Stack) ChainReaction(i int) int {
+	fallen := make([]bool, len(s.Bricks))
+	fallen[i] = true
+	count := 0
+	for j := i + 1; j < len(s.Bricks); j++ {
+		if len(s.SupportedBy[j]) == 0 {
+			continue
+		}
+		falls := true
+		for _, under := range s.SupportedBy[j] {
+			if !fallen[under] {
+				falls = false
+				break
+			}
+		}
+		if falls {
+			fallen[j] = true
+			count++
+		}
+	}
+	return count
+}
+
+// Isometric draws bricks as seen from above the far corner of the snapshot,
+// where x, y and z are largest, one character per cube. Each brick is drawn
+// with a letter, cycling through the alphabet in the order of bricks.
+func Isometric(bricks []Brick) string {
+	type cube struct {
+		Point
+		label byte
+	}
+	var cubes []cube
+	for i, b := range bricks {
+		for _, p := range b.Cubes() {
+			cubes = append(cubes, cube{Point: p, label: 'A' + byte(i%26)})
+		}
+	}
+	if len(cubes) == 0 {
+		return ""
+	}
+
+	// Moving towards the viewer, along (1, 1, 1), keeps a cube's place on
+	// screen, so nearer cubes are drawn over farther ones.
+	slices.SortFunc(cubes, func(a, b cube) int {
+		return cmp.Compare(a.X+a.Y+a.Z, b.X+b.Y+b.Z)
+	})
+	screen := func(p Point) grid.Vector {
+		return grid.Vector{X: p.X - p.Y, Y: p.X + p.Y - 2*p.Z}
+	}
+	lo, hi := screen(cubes[0].Point), screen(cubes[0].Point)
+	for _, c := range cubes {
+		v := screen(c.Point)
+		lo = grid.Vector{X: min(lo.X, v.X), Y: min(lo.Y, v.Y)}
+		hi = grid.Vector{X: max(hi.X, v.X), Y: max(hi.Y, v.Y)}
+	}
+
+	view := grid.New[byte](hi.X-lo.X+1, hi.Y-lo.Y+1)
+	view.Each(func(v grid.Vector, _ byte) {
+		view.Set(v, ' ')
+	})
+	for _, c := range cubes {
+		view.Set(screen(c.Point).Sub(lo), c.label)
+	}
+	return view.String()
+}
@@ -0,0 +1,58 @@
+package day22
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSettle(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	bricks, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Settle(bricks)
+
+	// The supports the puzzle text walks through, with A to G as 0 to 6.
+	wantSupports := [][]int{{1, 2}, {3, 4}, {3, 4}, {5}, {5}, {6}, nil}
+	wantSupportedBy := [][]int{nil, {0}, {0}, {1, 2}, {1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(s.Supports, wantSupports) {
+		t.Errorf("Supports = %v, want %v", s.Supports, wantSupports)
+	}
+	if !reflect.DeepEqual(s.SupportedBy, wantSupportedBy) {
+		t.Errorf("SupportedBy = %v, want %v", s.SupportedBy, wantSupportedBy)
+	}
+	if g := s.Bricks[6]; g.Min.Z != 5 || g.Max.Z != 6 {
+		t.Errorf("G settled at %v, want from z=5 to 6", g)
+	}
+
+	// Disintegrating A makes all the others fall, and F just G.
+	wantFalls := []int{6, 0, 0, 0, 0, 1, 0}
+	for i, want := range wantFalls {
+		if got := s.ChainReaction(i); got != want {
+			t.Errorf("ChainReaction(%d) = %d, want %d", i, got, want)
+		}
+		if got := s.Disintegrable(i); got != (want == 0) {
+			t.Errorf("Disintegrable(%d) = %v, want %v", i, got, want == 0)
+		}
+	}
+}
+
+func TestIsometric(t *testing.T) {
+	// Going up a level moves a cube two rows up the screen, and towards the
+	// viewer along x or y one row down.
+	bricks := []Brick{
+		{Min: Point{0, 0, 1}, Max: Point{0, 0, 2}},
+		{Min: Point{1, 0, 1}, Max: Point{1, 0, 1}},
+	}
+	want := "A \n  \nA \n B\n"
+	if got := Isometric(bricks); got != want {
+		t.Errorf("Isometric() = %q, want %q", got, want)
+	}
+}
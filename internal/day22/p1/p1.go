@@ -0,0 +1,30 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day22"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	bricks, err := day22.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	stack := day22.Settle(bricks)
+	aoc.Debugf("%s", day22.Isometric(stack.Bricks))
+	score := 0
+	for i := range stack.Bricks {
+		if stack.Disintegrable(i) {
+			aoc.Debugf("Brick %v can be disintegrated\n", stack.Bricks[i])
+			score++
+		}
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
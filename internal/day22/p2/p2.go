@@ -0,0 +1,28 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day22"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	bricks, err := day22.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	stack := day22.Settle(bricks)
+	score := 0
+	for i := range stack.Bricks {
+		falls := stack.ChainReaction(i)
+		aoc.Debugf("Disintegrating %v makes %d other bricks fall\n", stack.Bricks[i], falls)
+		score += falls
+	}
+
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,195 @@
+// Package day23 holds the hiking trail graph shared by both parts of day 23.
+package day23
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// Parse reads a map of paths (.), forest (#) and steep slopes (^, >, v and <).
+func Parse(r io.Reader) (*grid.Grid[byte], error) {
+	return grid.ParseFunc(r, func(c byte) (byte, error) {
+		switch c {
+		case '.', '#', '^', '>', 'v', '<':
+			return c, nil
+		}
+		return 0, &aoc.ParseError{Expected: "one of .#^>v<"}
+	})
+}
+
+var slopes = map[byte]grid.Vector{
+	'^': grid.UpVec,
+	'>': grid.RightVec,
+	'v': grid.DownVec,
+	'<': grid.LeftVec,
+}
+
+// Edge is a trail from one junction to another without passing any other.
+type Edge struct {
+	To, Steps int
+}
+
+// Graph is the hiking trails compressed to the places where a hiker has a
+// choice of way, and the trails between them.
+type Graph struct {
+	// Junctions are where on the map each junction is. Start and End index
+	// the start and end of the hike.
+	Junctions  []grid.Vector
+	Start, End int
+	// Edges are the trails leaving each junction.
+	Edges [][]Edge
+}
+
+// maxJunctions is how many junctions fit in the bitmask LongestHike keeps of
+// those visited.
+const maxJunctions = 64
+
+// Compress finds the junctions of trails and the lengths of the trails between
+// them. When slippery, slopes can only be walked down.
+func Compress(trails *grid.Grid[byte], slippery bool) (*Graph, error) {
+	open := func(v grid.Vector) bool {
+		return trails.GetOr(v, '#') != '#'
+	}
+	start, end, err := ends(trails)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Graph{}
+	index := map[grid.Vector]int{}
+	trails.Each(func(v grid.Vector, c byte) {
+		if !open(v) {
+			return
+		}
+		exits := 0
+		for _, n := range trails.Neighbours4(v) {
+			if open(n) {
+				exits++
+			}
+		}
+		if v == start || v == end || exits > 2 {
+			index[v] = len(g.Junctions)
+			g.Junctions = append(g.Junctions, v)
+		}
+	})
+	if len(g.Junctions) > maxJunctions {
+		return nil, fmt.Errorf("the trails have %d junctions, more than the %d that can be searched", len(g.Junctions), maxJunctions)
+	}
+	g.Start, g.End = index[start], index[end]
+
+	// moves are the ways a hiker at v may go.
+	moves := func(v grid.Vector) []grid.Vector {
+		if dir, ok := slopes[trails.GetOr(v, '#')]; ok && slippery {
+			return []grid.Vector{dir}
+		}
+		return grid.AllDirections
+	}
+	g.Edges = make([][]Edge, len(g.Junctions))
+	for from, junction := range g.Junctions {
+		for _, dir := range moves(junction) {
+			prev, pos := junction, junction.Add(dir)
+			if !open(pos) {
+				continue
+			}
+			for steps := 1; ; steps++ {
+				if to, ok := index[pos]; ok {
+					g.Edges[from] = append(g.Edges[from], Edge{To: to, Steps: steps})
+					break
+				}
+				next, ok := pos, false
+				for _, dir := range moves(pos) {
+					if n := pos.Add(dir); n != prev && open(n) {
+						next, ok = n, true
+						break
+					}
+				}
+				if !ok {
+					// A dead end, or a slope back the way we came.
+					break
+				}
+				prev, pos = pos, next
+			}
+		}
+	}
+	return g, nil
+}
+
+// ends finds the start of the hike, the single path tile in the top row, and
+// the end, the single path tile in the bottom row.
+func ends(trails *grid.Grid[byte]) (start, end grid.Vector, err error) {
+	find := func(y int) (grid.Vector, error) {
+		found := []grid.Vector{}
+		for x, c := range trails.Row(y) {
+			if c != '#' {
+				found = append(found, grid.Vector{X: x, Y: y})
+			}
+		}
+		if len(found) != 1 {
+			return grid.Vector{}, fmt.Errorf("row %d has %d paths, want 1", y+1, len(found))
+		}
+		return found[0], nil
+	}
+	if trails.Height() < 2 {
+		return start, end, errors.New("the map needs at least two rows")
+	}
+	if start, err = find(0); err != nil {
+		return start, end, err
+	}
+	end, err = find(trails.Height() - 1)
+	return start, end, err
+}
+
+// LongestHike returns the most steps a hike from Start to End can take without
+// visiting any tile twice, or false when there's no way to End at all. It
+// tries every path through the junctions depth first, keeping those visited as
+// a bitmask.
+func (g *Graph) LongestHike() (int, bool) {
+	// The junction before End is often the only way there. A hike passing it
+	// by could never come back to reach End, so from there it must go to End.
+	var into []int
+	for from, edges := range g.Edges {
+		for _, e := range edges {
+			if e.To == g.End && !slices.Contains(into, from) {
+				into = append(into, from)
+			}
+		}
+	}
+	last := -1
+	if len(into) == 1 {
+		last = into[0]
+	}
+
+	best := -1
+	var hike func(at int, visited uint64, steps int)
+	hike = func(at int, visited uint64, steps int) {
+		if at == g.End {
+			best = max(best, steps)
+			return
+		}
+		for _, e := range g.Edges[at] {
+			if at == last && e.To != g.End {
+				continue
+			}
+			if visited&(1<<e.To) == 0 {
+				hike(e.To, visited|1<<e.To, steps+e.Steps)
+			}
+		}
+	}
+	hike(g.Start, 1<<g.Start, 0)
+	aoc.Debugf("%d junctions, %d trails between them\n", len(g.Junctions), g.trails())
+	return best, best >= 0
+}
+
+// trails returns how many edges g has.
+func (g *Graph) trails() int {
+	n := 0
+	for _, edges := range g.Edges {
+		n += len(edges)
+	}
+	return n
+}
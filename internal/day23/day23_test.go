@@ -0,0 +1,139 @@
+package day23
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// lattice returns a map shaped like the puzzle input: an n by n grid of
+// junctions, spacing tiles apart, with slopes leading right and down out of
+// each of them. The hike goes from the top left junction to the bottom right.
+func lattice(n, spacing int) *grid.Grid[byte] {
+	size := 3 + (n-1)*spacing
+	trails := grid.New[byte](size, size)
+	trails.Each(func(v grid.Vector, _ byte) {
+		trails.Set(v, '#')
+	})
+	trails.Set(grid.Vector{X: 1, Y: 0}, '.')
+	trails.Set(grid.Vector{X: size - 2, Y: size - 1}, '.')
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			junction := grid.Vector{X: 1 + i*spacing, Y: 1 + j*spacing}
+			trails.Set(junction, '.')
+			for _, dir := range []grid.Vector{grid.RightVec, grid.DownVec} {
+				if (dir == grid.RightVec && i == n-1) || (dir == grid.DownVec && j == n-1) {
+					continue
+				}
+				for k := 1; k < spacing; k++ {
+					trails.Set(junction.Add(dir.Scale(k)), '.')
+				}
+				slope := byte('>')
+				if dir == grid.DownVec {
+					slope = 'v'
+				}
+				trails.Set(junction.Add(dir), slope)
+			}
+		}
+	}
+	return trails
+}
+
+// naiveLongestHike walks the map a tile at a time, for checking Compress and
+// LongestHike on small maps.
+func naiveLongestHike(trails *grid.Grid[byte], slippery bool) int {
+	start, end, _ := ends(trails)
+	visited := grid.New[bool](trails.Width(), trails.Height())
+	best := -1
+	var walk func(pos grid.Vector, steps int)
+	walk = func(pos grid.Vector, steps int) {
+		if pos == end {
+			best = max(best, steps)
+			return
+		}
+		visited.Set(pos, true)
+		dirs := grid.AllDirections
+		if dir, ok := slopes[trails.GetOr(pos, '#')]; ok && slippery {
+			dirs = []grid.Vector{dir}
+		}
+		for _, dir := range dirs {
+			n := pos.Add(dir)
+			if trails.GetOr(n, '#') != '#' && !visited.GetOr(n, true) {
+				walk(n, steps+1)
+			}
+		}
+		visited.Set(pos, false)
+	}
+	walk(start, 0)
+	return best
+}
+
+func TestLongestHike(t *testing.T) {
+	maps := []*grid.Grid[byte]{lattice(3, 3), lattice(4, 2)}
+	example, err := Parse(strings.NewReader(strings.Join([]string{
+		"#.#####",
+		"#...>.#",
+		"#.#v#.#",
+		"#.....#",
+		"###.#v#",
+		"###...#",
+		"#####.#",
+	}, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	maps = append(maps, example)
+
+	for _, trails := range maps {
+		for _, slippery := range []bool{true, false} {
+			g, err := Compress(trails, slippery)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := g.LongestHike()
+			if want := naiveLongestHike(trails, slippery); !ok || got != want {
+				t.Errorf("LongestHike(slippery %v) = %d, %v, want %d on\n%s", slippery, got, ok, want, trails)
+			}
+		}
+	}
+}
+
+func TestCompressErrors(t *testing.T) {
+	for _, input := range []string{
+		"#..#\n#..#\n#.##\n",
+		"#.#\n###\n",
+		"#.#\n",
+	} {
+		trails, err := Parse(strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Compress(trails, true); err == nil {
+			t.Errorf("Compress(%q) succeeded", input)
+		}
+	}
+}
+
+// BenchmarkLongestHike runs on a map the size and shape of a puzzle input,
+// whose 36 junctions make the search without slopes take the longest.
+func BenchmarkLongestHike(b *testing.B) {
+	trails := lattice(6, 27)
+	for _, slippery := range []bool{true, false} {
+		name := "dry"
+		if slippery {
+			name = "slippery"
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g, err := Compress(trails, slippery)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, ok := g.LongestHike(); !ok {
+					b.Fatal("no hike")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package p1
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day23"
+)
+
+// slippery means slopes can only be walked down.
+const slippery = true
+
+func Solve(r io.Reader) (string, error) {
+
+	trails, err := day23.Parse(r)
+	if err != nil {
+		return "", err
+	}
+	graph, err := day23.Compress(trails, slippery)
+	if err != nil {
+		return "", err
+	}
+
+	score, ok := graph.LongestHike()
+	if !ok {
+		return "", errors.New("there's no way to the end of the trails")
+	}
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
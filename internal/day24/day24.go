@@ -0,0 +1,232 @@
+// Package day24 holds the hailstone arithmetic shared by both parts of day 24.
+// Positions run to hundreds of trillions, so it is all done exactly, in
+// rationals, rather than trusting float64 with them.
+package day24
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+)
+
+type Vec3 struct {
+	X, Y, Z int
+}
+
+func (v Vec3) Sub(o Vec3) Vec3 {
+	return Vec3{X: v.X - o.X, Y: v.Y - o.Y, Z: v.Z - o.Z}
+}
+
+// Cross returns the cross product v × o. Its terms are products of positions
+// and velocities, which overflow an int, so it is in big integers.
+func (v Vec3) Cross(o Vec3) [3]*big.Int {
+	return [3]*big.Int{
+		mulSub(v.Y, o.Z, v.Z, o.Y),
+		mulSub(v.Z, o.X, v.X, o.Z),
+		mulSub(v.X, o.Y, v.Y, o.X),
+	}
+}
+
+// mulSub returns a*b - c*d, without overflowing.
+func mulSub(a, b, c, d int) *big.Int {
+	x := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	y := new(big.Int).Mul(big.NewInt(int64(c)), big.NewInt(int64(d)))
+	return x.Sub(x, y)
+}
+
+// Hailstone is a hailstone's position now and how far it moves each
+// nanosecond.
+type Hailstone struct {
+	Pos, Vel Vec3
+}
+
+func (h Hailstone) String() string {
+	return fmt.Sprintf("%d, %d, %d @ %d, %d, %d", h.Pos.X, h.Pos.Y, h.Pos.Z, h.Vel.X, h.Vel.Y, h.Vel.Z)
+}
+
+// Parse reads a hailstone per line.
+func Parse(r io.Reader) ([]Hailstone, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hail []Hailstone
+	for i, line := range lines {
+		var h Hailstone
+		if err := parse.Scanf(line, "{int}, {int}, {int} @ {int}, {int}, {int}", &h.Pos.X, &h.Pos.Y, &h.Pos.Z, &h.Vel.X, &h.Vel.Y, &h.Vel.Z); err != nil {
+			return nil, parse.AtLine(err, i+1, line)
+		}
+		hail = append(hail, h)
+	}
+	return hail, nil
+}
+
+func rat(n int) *big.Rat {
+	return new(big.Rat).SetInt64(int64(n))
+}
+
+// PathsCross returns where the paths of a and b cross in the X and Y axes,
+// ignoring Z, or false when they never do in the future of both.
+func PathsCross(a, b Hailstone) (x, y *big.Rat, ok bool) {
+	// a.Pos + t*a.Vel = b.Pos + s*b.Vel, solved for t and s by Cramer's rule.
+	det := mulSub(a.Vel.X, b.Vel.Y, a.Vel.Y, b.Vel.X)
+	if det.Sign() == 0 {
+		// Parallel paths. Hailstones on the same path are not counted.
+		return nil, nil, false
+	}
+	d := b.Pos.Sub(a.Pos)
+	t := new(big.Rat).SetFrac(mulSub(d.X, b.Vel.Y, d.Y, b.Vel.X), det)
+	s := new(big.Rat).SetFrac(mulSub(d.X, a.Vel.Y, d.Y, a.Vel.X), det)
+	if t.Sign() < 0 || s.Sign() < 0 {
+		return nil, nil, false
+	}
+
+	x = new(big.Rat).Mul(t, rat(a.Vel.X))
+	x.Add(x, rat(a.Pos.X))
+	y = new(big.Rat).Mul(t, rat(a.Vel.Y))
+	y.Add(y, rat(a.Pos.Y))
+	return x, y, true
+}
+
+// Crossings returns how many pairs of hail have paths that cross in the X and
+// Y axes in the future, inside the test area from lo to hi on both.
+func Crossings(hail []Hailstone, lo, hi int) int {
+	lower, upper := rat(lo), rat(hi)
+	inside := func(v *big.Rat) bool {
+		return v.Cmp(lower) >= 0 && v.Cmp(upper) <= 0
+	}
+
+	count := 0
+	for i, a := range hail {
+		for _, b := range hail[i+1:] {
+			if x, y, ok := PathsCross(a, b); ok && inside(x) && inside(y) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// ThrowRock finds the position and velocity to throw a rock from so that it
+// hits every hailstone.
+//
+// A rock at P moving at V hits hailstone i when P - p_i and V - v_i point the
+// same way, so (P - p_i) × (V - v_i) = 0. Expanded, that is
+//
+//	P×V - P×v_i - p_i×V + p_i×v_i = 0
+//
+// and the term P×V, which isn't linear in the unknowns, is the same for every
+// hailstone. Subtracting hailstone i's equations from hailstone j's leaves
+//
+//	P×(v_j - v_i) + (p_j - p_i)×V = p_j×v_j - p_i×v_i
+//
+// three linear equations in the six unknowns. The first hailstone paired with
+// a few others gives more than enough of them.
+func ThrowRock(hail []Hailstone) (pos, vel Vec3, err error) {
+	if len(hail) < 3 {
+		return pos, vel, errors.New("need at least three hailstones to aim at")
+	}
+
+	var a [][]*big.Rat
+	var b []*big.Rat
+	hi := hail[0]
+	for _, hj := range hail[1:min(len(hail), 5)] {
+		w := hj.Vel.Sub(hi.Vel)
+		d := hj.Pos.Sub(hi.Pos)
+		cj, ci := hj.Pos.Cross(hj.Vel), hi.Pos.Cross(hi.Vel)
+		// Coefficients of P.X, P.Y, P.Z, V.X, V.Y and V.Z.
+		for k, row := range [][6]int{
+			{0, w.Z, -w.Y, 0, -d.Z, d.Y},
+			{-w.Z, 0, w.X, d.Z, 0, -d.X},
+			{w.Y, -w.X, 0, -d.Y, d.X, 0},
+		} {
+			coeffs := make([]*big.Rat, 6)
+			for l := range coeffs {
+				coeffs[l] = rat(row[l])
+			}
+			a = append(a, coeffs)
+			b = append(b, new(big.Rat).SetInt(new(big.Int).Sub(cj[k], ci[k])))
+		}
+	}
+
+	x, err := solveLinear(a, b)
+	if err != nil {
+		return pos, vel, fmt.Errorf("no single throw hits every hailstone: %w", err)
+	}
+	var ints [6]int
+	for k, r := range x {
+		if !r.IsInt() || !r.Num().IsInt64() {
+			return pos, vel, fmt.Errorf("the rock would have to be thrown from %v, which isn't a whole position", x)
+		}
+		ints[k] = int(r.Num().Int64())
+	}
+	pos = Vec3{X: ints[0], Y: ints[1], Z: ints[2]}
+	vel = Vec3{X: ints[3], Y: ints[4], Z: ints[5]}
+
+	for _, h := range hail {
+		for _, c := range pos.Sub(h.Pos).Cross(vel.Sub(h.Vel)) {
+			if c.Sign() != 0 {
+				return pos, vel, fmt.Errorf("a rock thrown from %v at %v misses %v", pos, vel, h)
+			}
+		}
+	}
+	return pos, vel, nil
+}
+
+// solveLinear solves a x = b by Gauss-Jordan elimination. a may have more rows
+// than columns, as long as they are consistent and determine x exactly.
+func solveLinear(a [][]*big.Rat, b []*big.Rat) ([]*big.Rat, error) {
+	rows, cols := len(a), len(a[0])
+	m := make([][]*big.Rat, rows)
+	for r := range m {
+		m[r] = make([]*big.Rat, cols+1)
+		for c := 0; c < cols; c++ {
+			m[r][c] = new(big.Rat).Set(a[r][c])
+		}
+		m[r][cols] = new(big.Rat).Set(b[r])
+	}
+
+	tmp := new(big.Rat)
+	for col := 0; col < cols; col++ {
+		pivot := -1
+		for r := col; r < rows; r++ {
+			if m[r][col].Sign() != 0 {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			return nil, errors.New("the equations don't have a single solution")
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		inv := new(big.Rat).Inv(m[col][col])
+		for c := col; c <= cols; c++ {
+			m[col][c].Mul(m[col][c], inv)
+		}
+		for r := 0; r < rows; r++ {
+			if r == col || m[r][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(m[r][col])
+			for c := col; c <= cols; c++ {
+				m[r][c].Sub(m[r][c], tmp.Mul(f, m[col][c]))
+			}
+		}
+	}
+	for r := cols; r < rows; r++ {
+		if m[r][cols].Sign() != 0 {
+			return nil, errors.New("the equations contradict each other")
+		}
+	}
+
+	x := make([]*big.Rat, cols)
+	for c := range x {
+		x[c] = m[c][cols]
+	}
+	return x, nil
+}
@@ -0,0 +1,94 @@
+package day24
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func readExample(t *testing.T) []Hailstone {
+	t.Helper()
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	hail, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hail
+}
+
+func TestPathsCross(t *testing.T) {
+	hail := readExample(t)
+
+	// The crossings the puzzle text walks through, with A to E as 0 to 4.
+	tests := []struct {
+		a, b int
+		x, y string
+		ok   bool
+	}{
+		{0, 1, "43/3", "46/3", true},
+		{0, 2, "35/3", "50/3", true},
+		{0, 3, "31/5", "97/5", true},
+		{0, 4, "", "", false},
+		{1, 2, "", "", false},
+		{3, 4, "", "", false},
+	}
+	for _, tt := range tests {
+		x, y, ok := PathsCross(hail[tt.a], hail[tt.b])
+		if ok != tt.ok || ok && (x.RatString() != tt.x || y.RatString() != tt.y) {
+			t.Errorf("PathsCross(%v, %v) = %v, %v, %v, want %s, %s, %v", hail[tt.a], hail[tt.b], x, y, ok, tt.x, tt.y, tt.ok)
+		}
+	}
+
+	// Paths far enough out that Cramer's rule's products don't fit in an int.
+	a := Hailstone{Pos: Vec3{0, 0, 0}, Vel: Vec3{1, 1, 0}}
+	b := Hailstone{Pos: Vec3{400000000000000000, 0, 0}, Vel: Vec3{-1000, 1000, 0}}
+	if x, y, ok := PathsCross(a, b); !ok || x.RatString() != "200000000000000000" || y.RatString() != "200000000000000000" {
+		t.Errorf("PathsCross(%v, %v) = %v, %v, %v, want 200000000000000000 on both", a, b, x, y, ok)
+	}
+
+	if got := Crossings(hail, 7, 27); got != 2 {
+		t.Errorf("Crossings(7, 27) = %d, want 2", got)
+	}
+}
+
+func TestThrowRock(t *testing.T) {
+	hail := readExample(t)
+	pos, vel, err := ThrowRock(hail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Vec3{24, 13, 10}); pos != want || vel != (Vec3{-3, 1, 2}) {
+		t.Errorf("ThrowRock() = %v @ %v, want %v @ -3, 1, 2", pos, vel, want)
+	}
+
+	// Hail the size of a puzzle input's, placed where a known throw meets
+	// each stone at time t.
+	rock := Hailstone{Pos: Vec3{287430900705823, 451620998712421, 260730677041648}, Vel: Vec3{-63, -300, 64}}
+	var far []Hailstone
+	for i, v := range []Vec3{{85, -14, 91}, {-41, 232, -18}, {133, -76, 47}, {-52, 195, 68}, {11, -210, 202}} {
+		t := 600000000000 + i*37000000000
+		at := func(p, rv, hv int) int { return p + t*rv - t*hv }
+		far = append(far, Hailstone{
+			Pos: Vec3{at(rock.Pos.X, rock.Vel.X, v.X), at(rock.Pos.Y, rock.Vel.Y, v.Y), at(rock.Pos.Z, rock.Vel.Z, v.Z)},
+			Vel: v,
+		})
+	}
+	pos, vel, err = ThrowRock(far)
+	if err != nil || pos != rock.Pos || vel != rock.Vel {
+		t.Errorf("ThrowRock() = %v @ %v, %v, want %v", pos, vel, err, rock)
+	}
+
+	// Three stones in a row at the same speed can be hit by many throws.
+	inRow := []Hailstone{
+		{Pos: Vec3{0, 0, 0}, Vel: Vec3{1, 0, 0}},
+		{Pos: Vec3{1, 0, 0}, Vel: Vec3{1, 0, 0}},
+		{Pos: Vec3{2, 0, 0}, Vel: Vec3{1, 0, 0}},
+	}
+	if _, _, err := ThrowRock(inRow); err == nil {
+		t.Error("ThrowRock() of hail in a row succeeded")
+	}
+}
@@ -0,0 +1,27 @@
+package p1
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day24"
+)
+
+// The test area spans these X and Y positions, inclusive.
+const (
+	testAreaMin = 200000000000000
+	testAreaMax = 400000000000000
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	hail, err := day24.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	score := day24.Crossings(hail, testAreaMin, testAreaMax)
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,27 @@
+package p2
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day24"
+)
+
+func Solve(r io.Reader) (string, error) {
+
+	hail, err := day24.Parse(r)
+	if err != nil {
+		return "", err
+	}
+
+	pos, vel, err := day24.ThrowRock(hail)
+	if err != nil {
+		return "", err
+	}
+	aoc.Debugf("Throw from %d, %d, %d @ %d, %d, %d\n", pos.X, pos.Y, pos.Z, vel.X, vel.Y, vel.Z)
+
+	score := pos.X + pos.Y + pos.Z
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,194 @@
+// Package day25 holds the wiring graph and its minimum cut for day 25.
+package day25
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/parse"
+	"github.com/HugoKlepsch/AoC2023/internal/pqueue"
+)
+
+// Wire joins two components, by index into Graph.Names.
+type Wire [2]int
+
+// Graph is the components and the wires between them.
+type Graph struct {
+	Names []string
+	// Wires are in the order the wiring diagram lists them.
+	Wires []Wire
+}
+
+// Parse reads the wiring diagram, each line a component and the components it
+// is wired to. Wires aren't repeated the other way round.
+func Parse(r io.Reader) (*Graph, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Graph{}
+	index := map[string]int{}
+	component := func(name string) int {
+		i, ok := index[name]
+		if !ok {
+			i = len(g.Names)
+			index[name] = i
+			g.Names = append(g.Names, name)
+		}
+		return i
+	}
+	for i, line := range lines {
+		var name string
+		var others parse.Field
+		if err := parse.Scanf(line, "{word}: {rest}", &name, &others); err != nil {
+			return nil, parse.AtLine(err, i+1, line)
+		}
+		from := component(name)
+		for _, other := range others.Fields() {
+			g.Wires = append(g.Wires, Wire{from, component(other.Text)})
+		}
+	}
+	return g, nil
+}
+
+// Cut is a set of wires whose removal splits the components in two.
+type Cut struct {
+	Wires []Wire
+	// Side is true for the components on one side of the cut and false for
+	// those on the other.
+	Side []bool
+}
+
+// Sizes returns how many components are on each side of c.
+func (c Cut) Sizes() (int, int) {
+	n := 0
+	for _, side := range c.Side {
+		if side {
+			n++
+		}
+	}
+	return n, len(c.Side) - n
+}
+
+// MinCut returns a cut of g with the fewest wires, by the Stoer-Wagner
+// algorithm. Each phase adds components one at a time, most tightly wired to
+// those already added first. The last two added are merged into one, and the
+// wires joining the last to the rest are a cut; after every pair has been
+// merged, the smallest of those cuts is a minimum cut. g must have at least
+// two components.
+func (g *Graph) MinCut() Cut {
+	n := len(g.Names)
+	// weights holds, for each merged component still active, how many wires
+	// join it to each other one.
+	weights := make([]map[int]int, n)
+	members := make([][]int, n)
+	for i := range weights {
+		weights[i] = map[int]int{}
+		members[i] = []int{i}
+	}
+	for _, w := range g.Wires {
+		if w[0] != w[1] {
+			weights[w[0]][w[1]]++
+			weights[w[1]][w[0]]++
+		}
+	}
+
+	type queued struct {
+		component, weight int
+	}
+	active := make([]bool, n)
+	for i := range active {
+		active[i] = true
+	}
+	best, bestSide := -1, []int(nil)
+	added := make([]bool, n)
+	key := make([]int, n)
+	for phase := 0; phase < n-1; phase++ {
+		queue := pqueue.New(func(a, b queued) bool { return a.weight > b.weight })
+		for i := range key {
+			key[i], added[i] = 0, false
+			if active[i] {
+				queue.Push(queued{component: i})
+			}
+		}
+
+		prev, last := -1, -1
+		for queue.Len() > 0 {
+			q := queue.Pop()
+			if added[q.component] || q.weight != key[q.component] {
+				continue
+			}
+			added[q.component] = true
+			prev, last = last, q.component
+			for other, w := range weights[q.component] {
+				if !added[other] {
+					key[other] += w
+					queue.Push(queued{component: other, weight: key[other]})
+				}
+			}
+		}
+
+		if best < 0 || key[last] < best {
+			best = key[last]
+			bestSide = append([]int(nil), members[last]...)
+		}
+
+		// Merge last into prev.
+		for other, w := range weights[last] {
+			delete(weights[other], last)
+			if other != prev {
+				weights[prev][other] += w
+				weights[other][prev] += w
+			}
+		}
+		weights[last] = nil
+		members[prev] = append(members[prev], members[last]...)
+		active[last] = false
+	}
+
+	c := Cut{Side: make([]bool, n)}
+	for _, i := range bestSide {
+		c.Side[i] = true
+	}
+	for _, w := range g.Wires {
+		if c.Side[w[0]] != c.Side[w[1]] {
+			c.Wires = append(c.Wires, w)
+		}
+	}
+	return c
+}
+
+// WireName returns w as the puzzle text writes it, like hfx/pzl.
+func (g *Graph) WireName(w Wire) string {
+	return g.Names[w[0]] + "/" + g.Names[w[1]]
+}
+
+// DOT renders g as a Graphviz graph, with the components on each side of cut
+// in their own colour and the wires cut drawn in red.
+func (g *Graph) DOT(cut Cut) string {
+	cutWires := map[Wire]bool{}
+	for _, w := range cut.Wires {
+		cutWires[w] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("graph wiring {\n")
+	for i, name := range g.Names {
+		colour := "lightblue"
+		if cut.Side[i] {
+			colour = "lightsalmon"
+		}
+		fmt.Fprintf(&b, "\t%q [style=filled, fillcolor=%s];\n", name, colour)
+	}
+	for _, w := range g.Wires {
+		attrs := ""
+		if cutWires[w] {
+			attrs = " [color=red, penwidth=3]"
+		}
+		fmt.Fprintf(&b, "\t%q -- %q%s;\n", g.Names[w[0]], g.Names[w[1]], attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package day25
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMinCut(t *testing.T) {
+	f, err := os.Open(filepath.Join("p1", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	g, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cut := g.MinCut()
+	var got []string
+	for _, w := range cut.Wires {
+		names := []string{g.Names[w[0]], g.Names[w[1]]}
+		slices.Sort(names)
+		got = append(got, strings.Join(names, "/"))
+	}
+	slices.Sort(got)
+	// The wires the puzzle text cuts.
+	want := []string{"bvb/cmg", "hfx/pzl", "jqt/nvd"}
+	if !slices.Equal(got, want) {
+		t.Errorf("MinCut() cuts %v, want %v", got, want)
+	}
+	if a, b := cut.Sizes(); a*b != 54 {
+		t.Errorf("Sizes() = %d, %d, want 9 and 6", a, b)
+	}
+
+	dot := g.DOT(cut)
+	if !strings.HasPrefix(dot, "graph wiring {\n") || strings.Count(dot, "color=red") != 3 {
+		t.Errorf("DOT() doesn't mark the three wires cut:\n%s", dot)
+	}
+}
+
+// clusters returns a wiring diagram the size of a puzzle input: two densely
+// wired clusters of components joined by three wires.
+func clusters(size int) string {
+	rng := rand.New(rand.NewSource(25))
+	var b strings.Builder
+	for c := 0; c < 2; c++ {
+		for i := 0; i < size; i++ {
+			fmt.Fprintf(&b, "c%dn%d:", c, i)
+			for j := 0; j < 2; j++ {
+				fmt.Fprintf(&b, " c%dn%d", c, (i+1+rng.Intn(size-1))%size)
+			}
+			fmt.Fprintf(&b, " c%dn%d\n", c, (i+1)%size)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		fmt.Fprintf(&b, "c0n%d: c1n%d\n", i*7, i*11)
+	}
+	return b.String()
+}
+
+func BenchmarkMinCut(b *testing.B) {
+	g, err := Parse(strings.NewReader(clusters(750)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		if cut := g.MinCut(); len(cut.Wires) != 3 {
+			b.Fatalf("MinCut() cut %d wires, want 3", len(cut.Wires))
+		}
+	}
+}
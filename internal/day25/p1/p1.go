@@ -0,0 +1,38 @@
+package p1
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/day25"
+)
+
+// wires is how many wires must be cut to split the components in two.
+const wires = 3
+
+func Solve(r io.Reader) (string, error) {
+
+	graph, err := day25.Parse(r)
+	if err != nil {
+		return "", err
+	}
+	if len(graph.Names) < 2 {
+		return "", fmt.Errorf("%d components can't be split in two", len(graph.Names))
+	}
+
+	cut := graph.MinCut()
+	for _, w := range cut.Wires {
+		aoc.Debugf("Cut %s\n", graph.WireName(w))
+	}
+	if len(cut.Wires) != wires {
+		return "", fmt.Errorf("the components can be split by cutting %d wires, not %d", len(cut.Wires), wires)
+	}
+
+	a, b := cut.Sizes()
+	aoc.Debugf("Groups of %d and %d\n", a, b)
+	score := a * b
+	aoc.Debugf("Score: %d\n", score)
+	return strconv.Itoa(score), nil
+}
@@ -0,0 +1,300 @@
+// Package grid is a rectangular, two dimensional grid of cells, the shape most
+// of the map puzzles arrive in.
+package grid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vector is a position in a grid, or the offset between two positions. X grows
+// to the right and Y grows downwards, as the puzzle input reads.
+type Vector struct {
+	X, Y int
+}
+
+func (v Vector) Add(o Vector) Vector {
+	return Vector{
+		X: v.X + o.X,
+		Y: v.Y + o.Y,
+	}
+}
+
+func (v Vector) Sub(o Vector) Vector {
+	return Vector{
+		X: v.X - o.X,
+		Y: v.Y - o.Y,
+	}
+}
+
+func (v Vector) Scale(n int) Vector {
+	return Vector{
+		X: v.X * n,
+		Y: v.Y * n,
+	}
+}
+
+// Manhattan returns the taxicab length of v.
+func (v Vector) Manhattan() int {
+	return abs(v.X) + abs(v.Y)
+}
+
+// TurnRight rotates v a quarter turn clockwise, as seen on screen.
+func (v Vector) TurnRight() Vector {
+	return Vector{X: -v.Y, Y: v.X}
+}
+
+// TurnLeft rotates v a quarter turn anticlockwise, as seen on screen.
+func (v Vector) TurnLeft() Vector {
+	return Vector{X: v.Y, Y: -v.X}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+var (
+	UpVec    = Vector{Y: -1}
+	RightVec = Vector{X: 1}
+	DownVec  = Vector{Y: 1}
+	LeftVec  = Vector{X: -1}
+
+	UpRightVec   = UpVec.Add(RightVec)
+	DownRightVec = DownVec.Add(RightVec)
+	DownLeftVec  = DownVec.Add(LeftVec)
+	UpLeftVec    = UpVec.Add(LeftVec)
+
+	// AllDirections are the four orthogonal directions, clockwise from up.
+	AllDirections = []Vector{
+		UpVec,
+		RightVec,
+		DownVec,
+		LeftVec,
+	}
+
+	// AllDirectionsWithDiagonals are all eight directions, clockwise from up.
+	AllDirectionsWithDiagonals = []Vector{
+		UpVec,
+		UpRightVec,
+		RightVec,
+		DownRightVec,
+		DownVec,
+		DownLeftVec,
+		LeftVec,
+		UpLeftVec,
+	}
+)
+
+// Grid is a rectangular grid of cells of type T.
+type Grid[T any] struct {
+	width, height int
+	// cells holds the rows one after another.
+	cells []T
+}
+
+// New returns a width by height grid of zero valued cells.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{
+		width:  width,
+		height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// FromRows returns a grid holding a copy of rows, which must all be the same
+// length.
+func FromRows[T any](rows [][]T) (*Grid[T], error) {
+	g := &Grid[T]{height: len(rows)}
+	if len(rows) > 0 {
+		g.width = len(rows[0])
+	}
+	g.cells = make([]T, 0, g.width*g.height)
+	for y, row := range rows {
+		if len(row) != g.width {
+			return nil, fmt.Errorf("row %d has %d cells, want %d", y, len(row), g.width)
+		}
+		g.cells = append(g.cells, row...)
+	}
+	return g, nil
+}
+
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+func (g *Grid[T]) InBounds(v Vector) bool {
+	return v.X >= 0 && v.X < g.width && v.Y >= 0 && v.Y < g.height
+}
+
+// Get returns the cell at v, and false when v is outside the grid.
+func (g *Grid[T]) Get(v Vector) (T, bool) {
+	if !g.InBounds(v) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[v.Y*g.width+v.X], true
+}
+
+// GetOr returns the cell at v, or fallback when v is outside the grid.
+func (g *Grid[T]) GetOr(v Vector, fallback T) T {
+	if !g.InBounds(v) {
+		return fallback
+	}
+	return g.cells[v.Y*g.width+v.X]
+}
+
+// Set stores val at v, and reports false without storing it when v is outside
+// the grid.
+func (g *Grid[T]) Set(v Vector, val T) bool {
+	if !g.InBounds(v) {
+		return false
+	}
+	g.cells[v.Y*g.width+v.X] = val
+	return true
+}
+
+// Neighbours returns the positions one step from v in each of dirs that are
+// inside the grid.
+func (g *Grid[T]) Neighbours(v Vector, dirs []Vector) []Vector {
+	neighbours := make([]Vector, 0, len(dirs))
+	for _, dir := range dirs {
+		if n := v.Add(dir); g.InBounds(n) {
+			neighbours = append(neighbours, n)
+		}
+	}
+	return neighbours
+}
+
+// Neighbours4 returns the orthogonal neighbours of v inside the grid.
+func (g *Grid[T]) Neighbours4(v Vector) []Vector {
+	return g.Neighbours(v, AllDirections)
+}
+
+// Neighbours8 returns the orthogonal and diagonal neighbours of v inside the
+// grid.
+func (g *Grid[T]) Neighbours8(v Vector) []Vector {
+	return g.Neighbours(v, AllDirectionsWithDiagonals)
+}
+
+// Each calls fn for every cell, row by row.
+func (g *Grid[T]) Each(fn func(v Vector, val T)) {
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			fn(Vector{X: x, Y: y}, g.cells[y*g.width+x])
+		}
+	}
+}
+
+// IndexFunc returns the position of the first cell, row by row, satisfying f.
+func (g *Grid[T]) IndexFunc(f func(T) bool) (Vector, bool) {
+	for i, val := range g.cells {
+		if f(val) {
+			return Vector{X: i % g.width, Y: i / g.width}, true
+		}
+	}
+	return Vector{}, false
+}
+
+// Row returns row y. The returned slice shares storage with the grid, so
+// writes to it are writes to the grid.
+func (g *Grid[T]) Row(y int) []T {
+	return g.cells[y*g.width : (y+1)*g.width : (y+1)*g.width]
+}
+
+// Column returns a copy of column x.
+func (g *Grid[T]) Column(x int) []T {
+	column := make([]T, g.height)
+	for y := range column {
+		column[y] = g.cells[y*g.width+x]
+	}
+	return column
+}
+
+// Clone returns a copy of g.
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := New[T](g.width, g.height)
+	copy(c.cells, g.cells)
+	return c
+}
+
+// remap returns a width by height grid whose cell at v is g's cell at from(v).
+func (g *Grid[T]) remap(width, height int, from func(v Vector) Vector) *Grid[T] {
+	out := New[T](width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			src := from(Vector{X: x, Y: y})
+			out.cells[y*width+x] = g.cells[src.Y*g.width+src.X]
+		}
+	}
+	return out
+}
+
+// Transpose returns a copy of g mirrored along its leading diagonal, so rows
+// become columns.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.remap(g.height, g.width, func(v Vector) Vector {
+		return Vector{X: v.Y, Y: v.X}
+	})
+}
+
+// RotateClockwise returns a copy of g turned a quarter turn clockwise.
+func (g *Grid[T]) RotateClockwise() *Grid[T] {
+	return g.remap(g.height, g.width, func(v Vector) Vector {
+		return Vector{X: v.Y, Y: g.height - 1 - v.X}
+	})
+}
+
+// RotateAnticlockwise returns a copy of g turned a quarter turn anticlockwise.
+func (g *Grid[T]) RotateAnticlockwise() *Grid[T] {
+	return g.remap(g.height, g.width, func(v Vector) Vector {
+		return Vector{X: g.width - 1 - v.Y, Y: v.X}
+	})
+}
+
+// FlipHorizontal returns a copy of g mirrored left to right.
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.remap(g.width, g.height, func(v Vector) Vector {
+		return Vector{X: g.width - 1 - v.X, Y: v.Y}
+	})
+}
+
+// FlipVertical returns a copy of g mirrored top to bottom.
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.remap(g.width, g.height, func(v Vector) Vector {
+		return Vector{X: v.X, Y: g.height - 1 - v.Y}
+	})
+}
+
+// Format renders g one row per line, using cell to render each cell.
+func (g *Grid[T]) Format(cell func(v Vector, val T) string) string {
+	var b strings.Builder
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			v := Vector{X: x, Y: y}
+			b.WriteString(cell(v, g.cells[y*g.width+x]))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// String renders g one row per line. Byte and rune cells are printed as
+// characters, anything else as fmt's %v.
+func (g *Grid[T]) String() string {
+	return g.Format(func(_ Vector, val T) string {
+		switch c := any(val).(type) {
+		case byte:
+			return string(rune(c))
+		case rune:
+			return string(c)
+		}
+		return fmt.Sprint(val)
+	})
+}
@@ -0,0 +1,140 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+func mustParse(t *testing.T, s string) *Grid[byte] {
+	t.Helper()
+	g, err := Parse(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestGetSet(t *testing.T) {
+	g := mustParse(t, "ab\ncd\nef\n")
+	if g.Width() != 2 || g.Height() != 3 {
+		t.Fatalf("size = %dx%d, want 2x3", g.Width(), g.Height())
+	}
+	if c, ok := g.Get(Vector{X: 1, Y: 2}); !ok || c != 'f' {
+		t.Errorf("Get(1, 2) = %c, %v, want f, true", c, ok)
+	}
+	if _, ok := g.Get(Vector{X: 2, Y: 0}); ok {
+		t.Errorf("Get(2, 0) is in bounds")
+	}
+	if c := g.GetOr(Vector{X: -1, Y: 0}, '.'); c != '.' {
+		t.Errorf("GetOr(-1, 0) = %c, want .", c)
+	}
+	if !g.Set(Vector{X: 0, Y: 1}, 'z') || g.GetOr(Vector{X: 0, Y: 1}, '.') != 'z' {
+		t.Errorf("Set(0, 1) did not store")
+	}
+	if g.Set(Vector{X: 0, Y: 3}, 'z') {
+		t.Errorf("Set(0, 3) stored out of bounds")
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New[int](3, 3)
+	corner := g.Neighbours4(Vector{})
+	if want := []Vector{{X: 1}, {Y: 1}}; !reflect.DeepEqual(corner, want) {
+		t.Errorf("Neighbours4(0, 0) = %v, want %v", corner, want)
+	}
+	if n := g.Neighbours8(Vector{X: 1, Y: 1}); len(n) != 8 {
+		t.Errorf("Neighbours8(1, 1) has %d neighbours, want 8", len(n))
+	}
+	if n := g.Neighbours8(Vector{X: 2, Y: 1}); len(n) != 5 {
+		t.Errorf("Neighbours8(2, 1) has %d neighbours, want 5", len(n))
+	}
+}
+
+func TestRowColumn(t *testing.T) {
+	g := mustParse(t, "abc\ndef\n")
+	if got := string(g.Row(1)); got != "def" {
+		t.Errorf("Row(1) = %q, want def", got)
+	}
+	if got := string(g.Column(2)); got != "cf" {
+		t.Errorf("Column(2) = %q, want cf", got)
+	}
+	g.Row(0)[0] = 'z'
+	if c := g.GetOr(Vector{}, '.'); c != 'z' {
+		t.Errorf("writing to Row did not write to the grid")
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := mustParse(t, "abc\ndef\n")
+	tests := []struct {
+		name string
+		got  *Grid[byte]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"clockwise", g.RotateClockwise(), "da\neb\nfc\n"},
+		{"anticlockwise", g.RotateAnticlockwise(), "cf\nbe\nad\n"},
+		{"horizontal", g.FlipHorizontal(), "cba\nfed\n"},
+		{"vertical", g.FlipVertical(), "def\nabc\n"},
+		{"full turn", g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef\n"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s:\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse(strings.NewReader("abc\nde\n"))
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 {
+		t.Errorf("ragged row: err = %v, want ParseError on line 2", err)
+	}
+
+	digit := func(c byte) (int, error) {
+		if c < '0' || c > '9' {
+			return 0, &aoc.ParseError{Expected: "digit"}
+		}
+		return int(c - '0'), nil
+	}
+	_, err = ParseFunc(strings.NewReader("123\n4x6\n"), digit)
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Column != 2 || pe.Expected != "digit" {
+		t.Errorf("bad cell: err = %v, want ParseError at 2:2 expecting digit", err)
+	}
+
+	g, err := ParseFunc(strings.NewReader("123\n456\n\n"), digit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.String(); got != "123\n456\n" {
+		t.Errorf("int grid String() = %q", got)
+	}
+}
+
+func TestPolygon(t *testing.T) {
+	tests := []struct {
+		p                              Polygon
+		doubleArea, boundary, interior int
+	}{
+		{Polygon{{0, 0}, {2, 0}, {2, 2}, {0, 2}}, 8, 8, 1},
+		{Polygon{{0, 2}, {2, 2}, {2, 0}, {0, 0}}, 8, 8, 1},
+		{Polygon{{0, 0}, {4, 0}, {0, 4}}, 16, 12, 3},
+		{Polygon{{0, 0}, {1, 0}, {1, 1}, {0, 1}}, 2, 4, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.p.DoubleArea(); got != tt.doubleArea {
+			t.Errorf("%v.DoubleArea() = %d, want %d", tt.p, got, tt.doubleArea)
+		}
+		if got := tt.p.Boundary(); got != tt.boundary {
+			t.Errorf("%v.Boundary() = %d, want %d", tt.p, got, tt.boundary)
+		}
+		if got := tt.p.Interior(); got != tt.interior {
+			t.Errorf("%v.Interior() = %d, want %d", tt.p, got, tt.interior)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+// Parse reads a grid of characters, one row per line.
+func Parse(r io.Reader) (*Grid[byte], error) {
+	return ParseFunc(r, identity)
+}
+
+// ParseFunc reads a grid one row per line, converting each character with
+// cell. An error from cell is reported as a ParseError at that character; cell
+// may return a ParseError of its own to say what it expected.
+func ParseFunc[T any](r io.Reader, cell func(c byte) (T, error)) (*Grid[T], error) {
+	var lines []string
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Split(bufio.ScanLines)
+	for fileScanner.Scan() {
+		lines = append(lines, fileScanner.Text())
+	}
+	if err := fileScanner.Err(); err != nil {
+		return nil, err
+	}
+	return FromLinesFunc(lines, 1, cell)
+}
+
+// FromLines builds a grid of characters from lines, the first of which is line
+// firstLine of the input as far as any ParseError is concerned.
+func FromLines(lines []string, firstLine int) (*Grid[byte], error) {
+	return FromLinesFunc(lines, firstLine, identity)
+}
+
+// FromLinesFunc is FromLines, converting each character with cell as ParseFunc
+// does.
+func FromLinesFunc[T any](lines []string, firstLine int, cell func(c byte) (T, error)) (*Grid[T], error) {
+	// A trailing newline, or a few, is not a row.
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	g := &Grid[T]{height: len(lines)}
+	if len(lines) > 0 {
+		g.width = len(lines[0])
+	}
+	g.cells = make([]T, 0, g.width*g.height)
+	for y, line := range lines {
+		if len(line) != g.width {
+			return nil, &aoc.ParseError{Line: firstLine + y, Text: line, Expected: fmt.Sprintf("a row %d wide", g.width)}
+		}
+		for x := 0; x < len(line); x++ {
+			val, err := cell(line[x])
+			if err != nil {
+				var pe *aoc.ParseError
+				if !errors.As(err, &pe) {
+					pe = &aoc.ParseError{Err: err}
+				}
+				pe.Line = firstLine + y
+				pe.Column = x + 1
+				pe.Text = line
+				return nil, pe
+			}
+			g.cells = append(g.cells, val)
+		}
+	}
+	return g, nil
+}
+
+func identity(c byte) (byte, error) {
+	return c, nil
+}
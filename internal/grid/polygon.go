@@ -0,0 +1,37 @@
+package grid
+
+import "github.com/HugoKlepsch/AoC2023/internal/arith"
+
+// Polygon is a closed shape through its vertices in order, the last joining
+// back to the first. Its edges must not cross.
+type Polygon []Vector
+
+// DoubleArea returns twice the area of p by the shoelace formula. Doubled, it
+// stays whole for any polygon with lattice vertices.
+func (p Polygon) DoubleArea() int {
+	sum := 0
+	for i, v := range p {
+		next := p[(i+1)%len(p)]
+		sum += v.X*next.Y - next.X*v.Y
+	}
+	return abs(sum)
+}
+
+// Boundary returns how many lattice points lie on the edges of p.
+func (p Polygon) Boundary() int {
+	points := 0
+	for i, v := range p {
+		edge := p[(i+1)%len(p)].Sub(v)
+		points += arith.GCD(edge.X, edge.Y)
+	}
+	return points
+}
+
+// Interior returns how many lattice points lie strictly inside p, by Pick's
+// theorem: A = I + B/2 - 1.
+func (p Polygon) Interior() int {
+	if len(p) < 3 {
+		return 0
+	}
+	return (p.DoubleArea()-p.Boundary())/2 + 1
+}
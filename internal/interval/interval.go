@@ -0,0 +1,216 @@
+// Package interval does arithmetic on half-open integer intervals and sets of
+// them, so ranges of values can be pushed through a puzzle without visiting
+// every value in them.
+package interval
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Integer is the set of types an Interval can be made of.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Interval is the half-open range of values [Start, End).
+type Interval[T Integer] struct {
+	Start, End T
+}
+
+// FromLength returns the interval of length values starting at start.
+func FromLength[T Integer](start, length T) Interval[T] {
+	return Interval[T]{Start: start, End: start + length}
+}
+
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("[%d, %d)", iv.Start, iv.End)
+}
+
+// Len returns the number of values in iv.
+func (iv Interval[T]) Len() T {
+	if iv.Empty() {
+		return 0
+	}
+	return iv.End - iv.Start
+}
+
+func (iv Interval[T]) Empty() bool {
+	return iv.End <= iv.Start
+}
+
+func (iv Interval[T]) Contains(x T) bool {
+	return x >= iv.Start && x < iv.End
+}
+
+func (iv Interval[T]) Overlaps(o Interval[T]) bool {
+	return !iv.Intersect(o).Empty()
+}
+
+// Intersect returns the values in both iv and o. The result is empty when they
+// don't overlap.
+func (iv Interval[T]) Intersect(o Interval[T]) Interval[T] {
+	return Interval[T]{Start: max(iv.Start, o.Start), End: min(iv.End, o.End)}
+}
+
+// Shift returns iv moved by offset.
+func (iv Interval[T]) Shift(offset T) Interval[T] {
+	return Interval[T]{Start: iv.Start + offset, End: iv.End + offset}
+}
+
+// Union returns the values in either iv or o.
+func (iv Interval[T]) Union(o Interval[T]) Set[T] {
+	return NewSet(iv, o)
+}
+
+// Difference returns the values in iv but not in o.
+func (iv Interval[T]) Difference(o Interval[T]) Set[T] {
+	return NewSet(iv).Difference(NewSet(o))
+}
+
+// Set is a set of values kept as sorted, disjoint, non-adjacent intervals.
+type Set[T Integer] struct {
+	intervals []Interval[T]
+}
+
+// NewSet returns the set of values in any of intervals.
+func NewSet[T Integer](intervals ...Interval[T]) Set[T] {
+	return Set[T]{intervals: normalize(slices.Clone(intervals))}
+}
+
+// normalize sorts intervals and merges those that touch, in place.
+func normalize[T Integer](intervals []Interval[T]) []Interval[T] {
+	intervals = slices.DeleteFunc(intervals, Interval[T].Empty)
+	slices.SortFunc(intervals, func(a, b Interval[T]) int {
+		switch {
+		case a.Start < b.Start:
+			return -1
+		case a.Start > b.Start:
+			return 1
+		}
+		return 0
+	})
+
+	merged := intervals[:0]
+	for _, iv := range intervals {
+		if last := len(merged) - 1; last >= 0 && iv.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, iv.End)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+func (s Set[T]) String() string {
+	return fmt.Sprint(s.intervals)
+}
+
+// Intervals returns the sorted, disjoint intervals making up s.
+func (s Set[T]) Intervals() []Interval[T] {
+	return slices.Clone(s.intervals)
+}
+
+func (s Set[T]) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Len returns the number of values in s.
+func (s Set[T]) Len() T {
+	var n T
+	for _, iv := range s.intervals {
+		n += iv.Len()
+	}
+	return n
+}
+
+// Min returns the smallest value in s, and false when s is empty.
+func (s Set[T]) Min() (T, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Start, true
+}
+
+// Max returns the largest value in s, and false when s is empty.
+func (s Set[T]) Max() (T, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[len(s.intervals)-1].End - 1, true
+}
+
+func (s Set[T]) Contains(x T) bool {
+	i, found := slices.BinarySearchFunc(s.intervals, x, func(iv Interval[T], x T) int {
+		switch {
+		case iv.End <= x:
+			return -1
+		case iv.Start > x:
+			return 1
+		}
+		return 0
+	})
+	return found && s.intervals[i].Contains(x)
+}
+
+// Add returns s with the values in iv added.
+func (s Set[T]) Add(iv Interval[T]) Set[T] {
+	return NewSet(append(slices.Clone(s.intervals), iv)...)
+}
+
+// Union returns the values in either s or o.
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	return NewSet(append(slices.Clone(s.intervals), o.intervals...)...)
+}
+
+// Intersect returns the values in both s and o.
+func (s Set[T]) Intersect(o Set[T]) Set[T] {
+	var out []Interval[T]
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(o.intervals) {
+		a, b := s.intervals[i], o.intervals[j]
+		if overlap := a.Intersect(b); !overlap.Empty() {
+			out = append(out, overlap)
+		}
+		// Whichever ends first can't overlap anything further along.
+		if a.End < b.End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Set[T]{intervals: out}
+}
+
+// Difference returns the values in s but not in o.
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	var out []Interval[T]
+	j := 0
+	for _, a := range s.intervals {
+		// Skip what ends before a starts; it can't touch a or anything after it.
+		for j < len(o.intervals) && o.intervals[j].End <= a.Start {
+			j++
+		}
+		rest := a
+		for k := j; k < len(o.intervals) && o.intervals[k].Start < rest.End; k++ {
+			b := o.intervals[k]
+			if b.Start > rest.Start {
+				out = append(out, Interval[T]{Start: rest.Start, End: b.Start})
+			}
+			rest.Start = max(rest.Start, b.End)
+		}
+		if !rest.Empty() {
+			out = append(out, rest)
+		}
+	}
+	return Set[T]{intervals: out}
+}
+
+// Shift returns s with every value moved by offset.
+func (s Set[T]) Shift(offset T) Set[T] {
+	out := make([]Interval[T], len(s.intervals))
+	for i, iv := range s.intervals {
+		out[i] = iv.Shift(offset)
+	}
+	return Set[T]{intervals: out}
+}
@@ -0,0 +1,90 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+type iv = Interval[int64]
+
+func TestNewSetMerges(t *testing.T) {
+	s := NewSet(iv{10, 20}, iv{0, 5}, iv{5, 7}, iv{15, 25}, iv{30, 30})
+	want := []iv{{0, 7}, {10, 25}}
+	if got := s.Intervals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewSet = %v, want %v", got, want)
+	}
+	if s.Len() != 22 {
+		t.Errorf("Len = %d, want 22", s.Len())
+	}
+	if lo, _ := s.Min(); lo != 0 {
+		t.Errorf("Min = %d, want 0", lo)
+	}
+	if hi, _ := s.Max(); hi != 24 {
+		t.Errorf("Max = %d, want 24", hi)
+	}
+}
+
+func TestContains(t *testing.T) {
+	s := NewSet(iv{0, 5}, iv{10, 20})
+	for x, want := range map[int64]bool{-1: false, 0: true, 4: true, 5: false, 9: false, 10: true, 19: true, 20: false} {
+		if got := s.Contains(x); got != want {
+			t.Errorf("Contains(%d) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(iv{0, 10}, iv{20, 30})
+	b := NewSet(iv{5, 25}, iv{28, 40})
+	tests := []struct {
+		name string
+		got  Set[int64]
+		want []iv
+	}{
+		{"union", a.Union(b), []iv{{0, 40}}},
+		{"intersect", a.Intersect(b), []iv{{5, 10}, {20, 25}, {28, 30}}},
+		{"a - b", a.Difference(b), []iv{{0, 5}, {25, 28}}},
+		{"b - a", b.Difference(a), []iv{{10, 20}, {30, 40}}},
+		{"a - a", a.Difference(a), nil},
+		{"interval difference", iv{0, 10}.Difference(iv{3, 4}), []iv{{0, 3}, {4, 10}}},
+		{"interval union", iv{0, 3}.Union(iv{5, 8}), []iv{{0, 3}, {5, 8}}},
+	}
+	for _, tt := range tests {
+		if got := tt.got.Intervals(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOffsetMap(t *testing.T) {
+	// The seed-to-soil map from the day 5 example.
+	var m OffsetMap[int64]
+	m.AddRule(FromLength[int64](98, 2), 50-98)
+	m.AddRule(FromLength[int64](50, 48), 52-50)
+
+	for in, want := range map[int64]int64{79: 81, 14: 14, 55: 57, 13: 13, 98: 50, 99: 51, 100: 100} {
+		if got := m.Map(in); got != want {
+			t.Errorf("Map(%d) = %d, want %d", in, got, want)
+		}
+	}
+
+	got := m.MapSet(NewSet(iv{45, 101})).Intervals()
+	want := []iv{{45, 50}, {50, 52}, {52, 100}, {100, 101}}
+	if !reflect.DeepEqual(got, NewSet(want...).Intervals()) {
+		t.Errorf("MapSet = %v, want %v", got, NewSet(want...))
+	}
+
+	// Mapping a set must agree with mapping each of its values.
+	s := NewSet(iv{0, 10}, iv{47, 53}, iv{96, 103})
+	mapped := m.MapSet(s)
+	for _, piece := range s.Intervals() {
+		for x := piece.Start; x < piece.End; x++ {
+			if !mapped.Contains(m.Map(x)) {
+				t.Errorf("MapSet(%v) is missing %d, where %d maps to", s, m.Map(x), x)
+			}
+		}
+	}
+	if mapped.Len() != s.Len() {
+		t.Errorf("MapSet(%v) has %d values, want %d", s, mapped.Len(), s.Len())
+	}
+}
@@ -0,0 +1,48 @@
+package interval
+
+// OffsetRule moves every value in Interval by Offset.
+type OffsetRule[T Integer] struct {
+	Interval[T]
+	Offset T
+}
+
+// OffsetMap is a piecewise function that moves the values covered by each of
+// its rules by that rule's offset and leaves every other value where it is.
+// Where rules overlap, the one added first applies.
+type OffsetMap[T Integer] struct {
+	rules []OffsetRule[T]
+}
+
+// AddRule appends a rule moving the values in iv by offset.
+func (m *OffsetMap[T]) AddRule(iv Interval[T], offset T) {
+	m.rules = append(m.rules, OffsetRule[T]{Interval: iv, Offset: offset})
+}
+
+func (m *OffsetMap[T]) Rules() []OffsetRule[T] {
+	return m.rules
+}
+
+// Map returns where x is moved to.
+func (m *OffsetMap[T]) Map(x T) T {
+	for _, rule := range m.rules {
+		if rule.Contains(x) {
+			return x + rule.Offset
+		}
+	}
+	return x
+}
+
+// MapSet returns where every value in s is moved to, a piece at a time rather
+// than a value at a time.
+func (m *OffsetMap[T]) MapSet(s Set[T]) Set[T] {
+	var moved []Interval[T]
+	unmapped := s
+	for _, rule := range m.rules {
+		ruleSet := NewSet(rule.Interval)
+		for _, iv := range unmapped.Intersect(ruleSet).intervals {
+			moved = append(moved, iv.Shift(rule.Offset))
+		}
+		unmapped = unmapped.Difference(ruleSet)
+	}
+	return NewSet(append(moved, unmapped.intervals...)...)
+}
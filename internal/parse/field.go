@@ -0,0 +1,108 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+// Field is a piece of a line, along with where in the line it came from so
+// errors in it can point at the right column.
+type Field struct {
+	Text string
+	// Column is the 1-based byte column of Text in its line.
+	Column int
+}
+
+// Line is the field holding the whole of line.
+func Line(line string) Field {
+	return Field{Text: line, Column: 1}
+}
+
+var (
+	fieldRegex = regexp.MustCompile(`\S+`)
+	intRegex   = regexp.MustCompile(`-?\d+`)
+)
+
+// Fields splits line around runs of whitespace.
+func Fields(line string) []Field {
+	return Line(line).Fields()
+}
+
+// Split splits line around each sep, trimming whitespace from the pieces.
+func Split(line, sep string) []Field {
+	return Line(line).Split(sep)
+}
+
+// Ints returns every integer in line, ignoring whatever separates them.
+func Ints(line string) ([]int, error) {
+	return Line(line).Ints()
+}
+
+// Fields splits f around runs of whitespace.
+func (f Field) Fields() []Field {
+	var fields []Field
+	for _, loc := range fieldRegex.FindAllStringIndex(f.Text, -1) {
+		fields = append(fields, f.Slice(loc[0], loc[1]))
+	}
+	return fields
+}
+
+// Split splits f around each sep, trimming whitespace from the pieces.
+func (f Field) Split(sep string) []Field {
+	var fields []Field
+	start := 0
+	for {
+		end := strings.Index(f.Text[start:], sep)
+		if end < 0 {
+			return append(fields, f.Slice(start, len(f.Text)).TrimSpace())
+		}
+		fields = append(fields, f.Slice(start, start+end).TrimSpace())
+		start += end + len(sep)
+	}
+}
+
+// Slice is the field holding f.Text[start:end].
+func (f Field) Slice(start, end int) Field {
+	return Field{Text: f.Text[start:end], Column: f.Column + start}
+}
+
+// TrimSpace is f without leading and trailing whitespace.
+func (f Field) TrimSpace() Field {
+	trimmed := strings.TrimLeft(f.Text, " \t")
+	column := f.Column + len(f.Text) - len(trimmed)
+	return Field{Text: strings.TrimRight(trimmed, " \t"), Column: column}
+}
+
+// Int parses f as a decimal integer.
+func (f Field) Int() (int, error) {
+	val, err := strconv.Atoi(f.Text)
+	if err != nil {
+		return 0, f.Expected("integer", err)
+	}
+	return val, nil
+}
+
+// Ints returns every integer in f, ignoring whatever separates them.
+func (f Field) Ints() ([]int, error) {
+	var vals []int
+	for _, loc := range intRegex.FindAllStringIndex(f.Text, -1) {
+		val, err := f.Slice(loc[0], loc[1]).Int()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+// Expected reports that f isn't what was expected, with err as the cause if it
+// isn't nil.
+func (f Field) Expected(expected string, err error) error {
+	if ne, ok := err.(*strconv.NumError); ok {
+		err = ne.Err
+	}
+	return &aoc.ParseError{Column: f.Column, Text: f.Text, Expected: expected, Err: err}
+}
@@ -0,0 +1,96 @@
+// Package parse holds the input-reading helpers shared by the solvers. Every
+// helper reports malformed input as an aoc.ParseError; those parsing a single
+// line leave the line number to the caller, which fills it in with AtLine.
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	"github.com/HugoKlepsch/AoC2023/internal/grid"
+)
+
+// MaxLineLength is the longest line Lines and Paragraphs read. Some puzzles
+// are a single line far longer than bufio.Scanner allows by default.
+const MaxLineLength = 1 << 24
+
+// Lines reads every line of r, without line endings.
+func Lines(r io.Reader) ([]string, error) {
+	var lines []string
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Buffer(nil, MaxLineLength)
+	fileScanner.Split(bufio.ScanLines)
+	for fileScanner.Scan() {
+		lines = append(lines, fileScanner.Text())
+	}
+	if err := fileScanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// Paragraph is a block of consecutive non-blank lines.
+type Paragraph struct {
+	// Line is the 1-based line number of the first line.
+	Line  int
+	Lines []string
+}
+
+// Paragraphs reads r as blocks of lines separated by blank lines.
+func Paragraphs(r io.Reader) ([]Paragraph, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var paragraphs []Paragraph
+	var p *Paragraph
+	for i, line := range lines {
+		if line == "" {
+			p = nil
+			continue
+		}
+		if p == nil {
+			paragraphs = append(paragraphs, Paragraph{Line: i + 1})
+			p = &paragraphs[len(paragraphs)-1]
+		}
+		p.Lines = append(p.Lines, line)
+	}
+	return paragraphs, nil
+}
+
+// Grid reads the paragraph as a grid of characters.
+func (p Paragraph) Grid() (*grid.Grid[byte], error) {
+	return grid.FromLines(p.Lines, p.Line)
+}
+
+// Grids reads r as grids of characters separated by blank lines.
+func Grids(r io.Reader) ([]*grid.Grid[byte], error) {
+	paragraphs, err := Paragraphs(r)
+	if err != nil {
+		return nil, err
+	}
+	grids := make([]*grid.Grid[byte], 0, len(paragraphs))
+	for _, p := range paragraphs {
+		g, err := p.Grid()
+		if err != nil {
+			return nil, err
+		}
+		grids = append(grids, g)
+	}
+	return grids, nil
+}
+
+// AtLine records that err came from parsing line, line number lineNo of the
+// input, on any ParseError wrapped in err that doesn't know its line yet. It
+// returns err.
+func AtLine(err error, lineNo int, line string) error {
+	var pe *aoc.ParseError
+	if errors.As(err, &pe) && pe.Line == 0 {
+		pe.Line = lineNo
+		pe.Text = line
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		line string
+		want []int
+	}{
+		{"0 3 6 9 12 15", []int{0, 3, 6, 9, 12, 15}},
+		{"-1 -2  -3", []int{-1, -2, -3}},
+		{"Game 12: 3 blue, 4 red", []int{12, 3, 4}},
+		{"x=-4,y=7", []int{-4, 7}},
+		{"no numbers", nil},
+	}
+	for _, tt := range tests {
+		got, err := Ints(tt.line)
+		if err != nil {
+			t.Errorf("Ints(%q): %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Ints(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+
+	_, err := Ints("1 99999999999999999999")
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Column != 3 {
+		t.Errorf("Ints of an overflowing number = %v, want a ParseError at column 3", err)
+	}
+}
+
+func TestFieldsAndSplit(t *testing.T) {
+	got := Fields("  Time:  7 15")
+	want := []Field{{"Time:", 3}, {"7", 10}, {"15", 12}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+
+	got = Split("3 blue, 4 red; 1 red", ";")
+	want = []Field{{"3 blue, 4 red", 1}, {"1 red", 16}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %v, want %v", got, want)
+	}
+	got = got[0].Split(",")
+	want = []Field{{"3 blue", 1}, {"4 red", 9}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() of a field = %v, want %v", got, want)
+	}
+}
+
+func TestScanf(t *testing.T) {
+	var id int
+	var rest string
+	if err := Scanf("Game 12: 3 blue, 4 red", "Game {int}: {rest}", &id, &rest); err != nil {
+		t.Fatal(err)
+	}
+	if id != 12 || rest != "3 blue, 4 red" {
+		t.Errorf("Scanf() = %d, %q", id, rest)
+	}
+
+	var card int
+	var winners, have []int
+	if err := Scanf("Card   1: 41 48 | 83  6 -1", "Card {int}: {ints} | {ints}", &card, &winners, &have); err != nil {
+		t.Fatal(err)
+	}
+	if card != 1 || !reflect.DeepEqual(winners, []int{41, 48}) || !reflect.DeepEqual(have, []int{83, 6, -1}) {
+		t.Errorf("Scanf() = %d, %v, %v", card, winners, have)
+	}
+
+	var name Field
+	if err := Scanf("seed-to-soil map:", "{word} map:", &name); err != nil {
+		t.Fatal(err)
+	}
+	if name != (Field{"seed-to-soil", 1}) {
+		t.Errorf("Scanf() into a Field = %v", name)
+	}
+}
+
+func TestScanfErrors(t *testing.T) {
+	tests := []struct {
+		line, format string
+		column       int
+	}{
+		{"Game x: 1 red", "Game {int}: {rest}", 6},
+		{"Card 1: 41 4x | 83", "Card {int}: {ints} | {ints}", 12},
+		{"Gaem 1: 1 red", "Game {int}: {rest}", 1},
+	}
+	for _, tt := range tests {
+		var a int
+		var b, c []int
+		var rest string
+		args := []any{&a, &rest}
+		if strings.Count(tt.format, "{") == 3 {
+			args = []any{&a, &b, &c}
+		}
+		err := AtLine(Scanf(tt.line, tt.format, args...), 7, tt.line)
+		var pe *aoc.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Scanf(%q, %q) = %v, want a ParseError", tt.line, tt.format, err)
+			continue
+		}
+		if pe.Line != 7 || pe.Column != tt.column || pe.Text != tt.line {
+			t.Errorf("Scanf(%q, %q) error at %d:%d in %q, want 7:%d", tt.line, tt.format, pe.Line, pe.Column, pe.Text, tt.column)
+		}
+	}
+
+	var s string
+	if err := Scanf("1", "{int}", &s, &s); err == nil {
+		t.Error("Scanf() with too many args succeeded")
+	}
+	if err := Scanf("1", "{float}", &s); err == nil {
+		t.Error("Scanf() with an unknown verb succeeded")
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	input := "seeds: 79 14\n\nseed-to-soil map:\n50 98 2\n52 50 48\n\n\n#.#\n.#.\n"
+	got, err := Paragraphs(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Paragraph{
+		{Line: 1, Lines: []string{"seeds: 79 14"}},
+		{Line: 3, Lines: []string{"seed-to-soil map:", "50 98 2", "52 50 48"}},
+		{Line: 8, Lines: []string{"#.#", ".#."}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Paragraphs() = %v, want %v", got, want)
+	}
+
+	_, err = Grids(strings.NewReader("#.#\n.#.\n\n##\n#\n"))
+	var pe *aoc.ParseError
+	if !errors.As(err, &pe) || pe.Line != 5 {
+		t.Errorf("Grids() of a ragged grid = %v, want a ParseError on line 5", err)
+	}
+}
+
+func TestLinesLongerThanScannerDefault(t *testing.T) {
+	long := strings.Repeat("rn=1,", 20000)
+	lines, err := Lines(strings.NewReader(long + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || lines[0] != long {
+		t.Errorf("Lines() returned %d lines, want the one long line", len(lines))
+	}
+}
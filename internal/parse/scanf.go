@@ -0,0 +1,131 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// verbs are the placeholders a Scanf format may contain, and the text each
+// matches before it is converted.
+var verbs = map[string]string{
+	"int":  `(\S+?)`,
+	"word": `(\S+)`,
+	"ints": `(.*?)`,
+	"rest": `(.*?)`,
+}
+
+type format struct {
+	regex *regexp.Regexp
+	verbs []string
+}
+
+var (
+	placeholderRegex = regexp.MustCompile(`\{(\w+)\}|\s+`)
+	formats          sync.Map // format string to *format
+)
+
+func compile(f string) (*format, error) {
+	if cached, ok := formats.Load(f); ok {
+		return cached.(*format), nil
+	}
+
+	compiled := &format{}
+	var expr strings.Builder
+	expr.WriteString(`^`)
+	last := 0
+	for _, loc := range placeholderRegex.FindAllStringSubmatchIndex(f, -1) {
+		expr.WriteString(regexp.QuoteMeta(f[last:loc[0]]))
+		last = loc[1]
+		if loc[2] < 0 {
+			expr.WriteString(`\s+`)
+			continue
+		}
+		verb := f[loc[2]:loc[3]]
+		pattern, ok := verbs[verb]
+		if !ok {
+			return nil, fmt.Errorf("parse: unknown verb {%s} in %q", verb, f)
+		}
+		expr.WriteString(pattern)
+		compiled.verbs = append(compiled.verbs, verb)
+	}
+	expr.WriteString(regexp.QuoteMeta(f[last:]))
+	expr.WriteString(`\s*$`)
+
+	regex, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse: %q: %w", f, err)
+	}
+	compiled.regex = regex
+	formats.Store(f, compiled)
+	return compiled, nil
+}
+
+// Scanf matches line against format, storing the text matched by each of its
+// placeholders into the corresponding arg. Literal text in format must match
+// exactly, except that a run of whitespace matches any run of whitespace.
+// The placeholders are:
+//
+//	{int}   a decimal integer, into an *int
+//	{ints}  whitespace separated integers, into an *[]int
+//	{word}  a run of non-whitespace, into a *string
+//	{rest}  anything at all, into a *string
+//
+// Any placeholder may be stored into a *Field instead, to keep its column.
+func Scanf(line, format string, args ...any) error {
+	return Line(line).Scanf(format, args...)
+}
+
+// Scanf is the package's Scanf on f.
+func (f Field) Scanf(format string, args ...any) error {
+	compiled, err := compile(format)
+	if err != nil {
+		return err
+	}
+	if len(args) != len(compiled.verbs) {
+		return fmt.Errorf("parse: %q has %d placeholders but was given %d args", format, len(compiled.verbs), len(args))
+	}
+
+	loc := compiled.regex.FindStringSubmatchIndex(f.Text)
+	if loc == nil {
+		return f.Expected(fmt.Sprintf("%q", format), nil)
+	}
+	for i, verb := range compiled.verbs {
+		if err := store(f.Slice(loc[2+2*i], loc[3+2*i]), verb, args[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func store(f Field, verb string, arg any) error {
+	switch arg := arg.(type) {
+	case *Field:
+		*arg = f
+		return nil
+	case *string:
+		*arg = f.Text
+		return nil
+	case *int:
+		if verb == "int" {
+			val, err := f.Int()
+			*arg = val
+			return err
+		}
+	case *[]int:
+		if verb == "ints" {
+			vals := []int{}
+			for _, field := range f.Fields() {
+				val, err := field.Int()
+				if err != nil {
+					return err
+				}
+				vals = append(vals, val)
+			}
+			*arg = vals
+			return nil
+		}
+	}
+	return fmt.Errorf("parse: cannot store {%s} into %T", verb, arg)
+}
@@ -0,0 +1,64 @@
+// Package pqueue is a generic priority queue, for the searches that need to
+// expand the cheapest state first.
+package pqueue
+
+// Queue is a binary min-heap of items ordered by less.
+type Queue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// New returns an empty queue that pops the item for which less reports true
+// against every other item first.
+func New[T any](less func(a, b T) bool) *Queue[T] {
+	return &Queue[T]{less: less}
+}
+
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+// Push adds x to the queue.
+func (q *Queue[T]) Push(x T) {
+	q.items = append(q.items, x)
+	i := len(q.items) - 1
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i], q.items[parent]) {
+			break
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+// Pop removes and returns the least item. It panics on an empty queue.
+func (q *Queue[T]) Pop() T {
+	top := q.items[0]
+	last := len(q.items) - 1
+	q.items[0] = q.items[last]
+	var zero T
+	q.items[last] = zero
+	q.items = q.items[:last]
+
+	i := 0
+	for {
+		least := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(q.items) && q.less(q.items[child], q.items[least]) {
+				least = child
+			}
+		}
+		if least == i {
+			return top
+		}
+		q.items[i], q.items[least] = q.items[least], q.items[i]
+		i = least
+	}
+}
+
+// Peek returns the least item without removing it. It panics on an empty
+// queue.
+func (q *Queue[T]) Peek() T {
+	return q.items[0]
+}
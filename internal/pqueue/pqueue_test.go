@@ -0,0 +1,47 @@
+package pqueue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := New(func(a, b int) bool { return a < b })
+	rng := rand.New(rand.NewSource(17))
+	var want []int
+	for i := 0; i < 1000; i++ {
+		x := rng.Intn(100)
+		q.Push(x)
+		want = append(want, x)
+	}
+	sort.Ints(want)
+
+	if q.Len() != len(want) || q.Peek() != want[0] {
+		t.Fatalf("Len() = %d, Peek() = %d, want %d, %d", q.Len(), q.Peek(), len(want), want[0])
+	}
+	for i, w := range want {
+		if got := q.Pop(); got != w {
+			t.Fatalf("Pop() %d = %d, want %d", i, got, w)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d after popping everything", q.Len())
+	}
+}
+
+func TestQueueOfStructs(t *testing.T) {
+	type job struct {
+		name string
+		cost int
+	}
+	q := New(func(a, b job) bool { return a.cost < b.cost })
+	q.Push(job{"c", 3})
+	q.Push(job{"a", 1})
+	q.Push(job{"b", 2})
+	for _, want := range []string{"a", "b", "c"} {
+		if got := q.Pop(); got.name != want {
+			t.Errorf("Pop() = %s, want %s", got.name, want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Package sim holds helpers for puzzles that run a process step by step for
+// far more steps than could ever be simulated.
+package sim
+
+// Cycle is a sequence of states that, after Start steps, repeats every Period
+// steps.
+type Cycle[S any] struct {
+	Start, Period int
+	// States holds each state up to the one that repeats the state at Start,
+	// States[0] being the initial one.
+	States []S
+}
+
+// At returns the state after n steps.
+func (c Cycle[S]) At(n int) S {
+	if n < len(c.States) {
+		return c.States[n]
+	}
+	return c.States[c.Start+(n-c.Start)%c.Period]
+}
+
+// FindCycle steps from state until it reaches a state it has seen before, as
+// told by key, and returns the cycle found. step must return a new state
+// rather than change the one it is given, since every state is kept. It never
+// returns for a process that doesn't repeat.
+func FindCycle[S any, K comparable](state S, step func(S) S, key func(S) K) Cycle[S] {
+	seen := map[K]int{}
+	var states []S
+	for {
+		k := key(state)
+		if first, ok := seen[k]; ok {
+			return Cycle[S]{Start: first, Period: len(states) - first, States: states}
+		}
+		seen[k] = len(states)
+		states = append(states, state)
+		state = step(state)
+	}
+}
@@ -0,0 +1,29 @@
+package sim
+
+import "testing"
+
+func TestFindCycle(t *testing.T) {
+	// 3, 10, 5, 16, 8, 4, 2, 1, 4, 2, 1, ...
+	collatz := func(n int) int {
+		if n%2 == 0 {
+			return n / 2
+		}
+		return 3*n + 1
+	}
+	identity := func(n int) int { return n }
+
+	c := FindCycle(3, collatz, identity)
+	if c.Start != 5 || c.Period != 3 {
+		t.Fatalf("FindCycle() = start %d period %d, want start 5 period 3", c.Start, c.Period)
+	}
+	for n, want := range map[int]int{0: 3, 4: 8, 7: 1, 8: 4, 1000000000: 1} {
+		if got := c.At(n); got != want {
+			t.Errorf("At(%d) = %d, want %d", n, got, want)
+		}
+	}
+
+	c = FindCycle(7, identity, identity)
+	if c.Start != 0 || c.Period != 1 || c.At(99) != 7 {
+		t.Errorf("FindCycle() of a fixed point = %+v", c)
+	}
+}
@@ -0,0 +1,37 @@
+package solutions
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/HugoKlepsch/AoC2023/internal/bench"
+)
+
+// BenchmarkSolutions benchmarks every solution on its private input, or on its
+// first example when the private input is absent. Run a single one with e.g.
+// -bench Solutions/day05/p2.
+func BenchmarkSolutions(b *testing.B) {
+	answers := readAnswers(b)
+
+	for _, s := range All {
+		s := s
+		b.Run(fmt.Sprintf("day%02d/p%d", s.Day, s.Part), func(b *testing.B) {
+			input, err := os.ReadFile(answer{day: s.Day, part: s.Part, input: privateInput}.path())
+			if errors.Is(err, fs.ErrNotExist) {
+				for _, a := range answers {
+					if a.day == s.Day && a.part == s.Part && a.input != privateInput {
+						input, err = os.ReadFile(a.path())
+						break
+					}
+				}
+			}
+			if err != nil {
+				b.Fatal(err)
+			}
+			bench.Func(s.Solve, input)(b)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Package solutions is the registry of every implemented puzzle, used by the
+// aoc runner to find a solver by day and part.
+package solutions
+
+import (
+	"fmt"
+
+	"github.com/HugoKlepsch/AoC2023/internal/aoc"
+	day01p1 "github.com/HugoKlepsch/AoC2023/internal/day01/p1"
+	day01p2 "github.com/HugoKlepsch/AoC2023/internal/day01/p2"
+	day02p1 "github.com/HugoKlepsch/AoC2023/internal/day02/p1"
+	day02p2 "github.com/HugoKlepsch/AoC2023/internal/day02/p2"
+	day03p1 "github.com/HugoKlepsch/AoC2023/internal/day03/p1"
+	day03p2 "github.com/HugoKlepsch/AoC2023/internal/day03/p2"
+	day04p1 "github.com/HugoKlepsch/AoC2023/internal/day04/p1"
+	day04p2 "github.com/HugoKlepsch/AoC2023/internal/day04/p2"
+	day05p1 "github.com/HugoKlepsch/AoC2023/internal/day05/p1"
+	day05p2 "github.com/HugoKlepsch/AoC2023/internal/day05/p2"
+	day06p1 "github.com/HugoKlepsch/AoC2023/internal/day06/p1"
+	day06p2 "github.com/HugoKlepsch/AoC2023/internal/day06/p2"
+	day07p1 "github.com/HugoKlepsch/AoC2023/internal/day07/p1"
+	day07p2 "github.com/HugoKlepsch/AoC2023/internal/day07/p2"
+	day08p1 "github.com/HugoKlepsch/AoC2023/internal/day08/p1"
+	day08p2 "github.com/HugoKlepsch/AoC2023/internal/day08/p2"
+	day09p1 "github.com/HugoKlepsch/AoC2023/internal/day09/p1"
+	day09p2 "github.com/HugoKlepsch/AoC2023/internal/day09/p2"
+	day10p1 "github.com/HugoKlepsch/AoC2023/internal/day10/p1"
+	day10p2 "github.com/HugoKlepsch/AoC2023/internal/day10/p2"
+	day11p1 "github.com/HugoKlepsch/AoC2023/internal/day11/p1"
+	day11p2 "github.com/HugoKlepsch/AoC2023/internal/day11/p2"
+	day12p1 "github.com/HugoKlepsch/AoC2023/internal/day12/p1"
+	day12p2 "github.com/HugoKlepsch/AoC2023/internal/day12/p2"
+	day13p1 "github.com/HugoKlepsch/AoC2023/internal/day13/p1"
+	day13p2 "github.com/HugoKlepsch/AoC2023/internal/day13/p2"
+	day14p1 "github.com/HugoKlepsch/AoC2023/internal/day14/p1"
+	day14p2 "github.com/HugoKlepsch/AoC2023/internal/day14/p2"
+	day15p1 "github.com/HugoKlepsch/AoC2023/internal/day15/p1"
+	day15p2 "github.com/HugoKlepsch/AoC2023/internal/day15/p2"
+	day16p1 "github.com/HugoKlepsch/AoC2023/internal/day16/p1"
+	day16p2 "github.com/HugoKlepsch/AoC2023/internal/day16/p2"
+	day17p1 "github.com/HugoKlepsch/AoC2023/internal/day17/p1"
+	day17p2 "github.com/HugoKlepsch/AoC2023/internal/day17/p2"
+	day18p1 "github.com/HugoKlepsch/AoC2023/internal/day18/p1"
+	day18p2 "github.com/HugoKlepsch/AoC2023/internal/day18/p2"
+	day19p1 "github.com/HugoKlepsch/AoC2023/internal/day19/p1"
+	day19p2 "github.com/HugoKlepsch/AoC2023/internal/day19/p2"
+	day20p1 "github.com/HugoKlepsch/AoC2023/internal/day20/p1"
+	day20p2 "github.com/HugoKlepsch/AoC2023/internal/day20/p2"
+	day21p1 "github.com/HugoKlepsch/AoC2023/internal/day21/p1"
+	day21p2 "github.com/HugoKlepsch/AoC2023/internal/day21/p2"
+	day22p1 "github.com/HugoKlepsch/AoC2023/internal/day22/p1"
+	day22p2 "github.com/HugoKlepsch/AoC2023/internal/day22/p2"
+	day23p1 "github.com/HugoKlepsch/AoC2023/internal/day23/p1"
+	day23p2 "github.com/HugoKlepsch/AoC2023/internal/day23/p2"
+	day24p1 "github.com/HugoKlepsch/AoC2023/internal/day24/p1"
+	day24p2 "github.com/HugoKlepsch/AoC2023/internal/day24/p2"
+	day25p1 "github.com/HugoKlepsch/AoC2023/internal/day25/p1"
+)
+
+type Solution struct {
+	Day, Part int
+	Solve     aoc.Solver
+}
+
+func (s Solution) String() string {
+	return fmt.Sprintf("day %02d part %d", s.Day, s.Part)
+}
+
+// All lists the solutions in calendar order.
+var All = []Solution{
+	{Day: 1, Part: 1, Solve: day01p1.Solve},
+	{Day: 1, Part: 2, Solve: day01p2.Solve},
+	{Day: 2, Part: 1, Solve: day02p1.Solve},
+	{Day: 2, Part: 2, Solve: day02p2.Solve},
+	{Day: 3, Part: 1, Solve: day03p1.Solve},
+	{Day: 3, Part: 2, Solve: day03p2.Solve},
+	{Day: 4, Part: 1, Solve: day04p1.Solve},
+	{Day: 4, Part: 2, Solve: day04p2.Solve},
+	{Day: 5, Part: 1, Solve: day05p1.Solve},
+	{Day: 5, Part: 2, Solve: day05p2.Solve},
+	{Day: 6, Part: 1, Solve: day06p1.Solve},
+	{Day: 6, Part: 2, Solve: day06p2.Solve},
+	{Day: 7, Part: 1, Solve: day07p1.Solve},
+	{Day: 7, Part: 2, Solve: day07p2.Solve},
+	{Day: 8, Part: 1, Solve: day08p1.Solve},
+	{Day: 8, Part: 2, Solve: day08p2.Solve},
+	{Day: 9, Part: 1, Solve: day09p1.Solve},
+	{Day: 9, Part: 2, Solve: day09p2.Solve},
+	{Day: 10, Part: 1, Solve: day10p1.Solve},
+	{Day: 10, Part: 2, Solve: day10p2.Solve},
+	{Day: 11, Part: 1, Solve: day11p1.Solve},
+	{Day: 11, Part: 2, Solve: day11p2.Solve},
+	{Day: 12, Part: 1, Solve: day12p1.Solve},
+	{Day: 12, Part: 2, Solve: day12p2.Solve},
+	{Day: 13, Part: 1, Solve: day13p1.Solve},
+	{Day: 13, Part: 2, Solve: day13p2.Solve},
+	{Day: 14, Part: 1, Solve: day14p1.Solve},
+	{Day: 14, Part: 2, Solve: day14p2.Solve},
+	{Day: 15, Part: 1, Solve: day15p1.Solve},
+	{Day: 15, Part: 2, Solve: day15p2.Solve},
+	{Day: 16, Part: 1, Solve: day16p1.Solve},
+	{Day: 16, Part: 2, Solve: day16p2.Solve},
+	{Day: 17, Part: 1, Solve: day17p1.Solve},
+	{Day: 17, Part: 2, Solve: day17p2.Solve},
+	{Day: 18, Part: 1, Solve: day18p1.Solve},
+	{Day: 18, Part: 2, Solve: day18p2.Solve},
+	{Day: 19, Part: 1, Solve: day19p1.Solve},
+	{Day: 19, Part: 2, Solve: day19p2.Solve},
+	{Day: 20, Part: 1, Solve: day20p1.Solve},
+	{Day: 20, Part: 2, Solve: day20p2.Solve},
+	{Day: 21, Part: 1, Solve: day21p1.Solve},
+	{Day: 21, Part: 2, Solve: day21p2.Solve},
+	{Day: 22, Part: 1, Solve: day22p1.Solve},
+	{Day: 22, Part: 2, Solve: day22p2.Solve},
+	{Day: 23, Part: 1, Solve: day23p1.Solve},
+	{Day: 23, Part: 2, Solve: day23p2.Solve},
+	{Day: 24, Part: 1, Solve: day24p1.Solve},
+	{Day: 24, Part: 2, Solve: day24p2.Solve},
+	{Day: 25, Part: 1, Solve: day25p1.Solve},
+}
+
+// Find returns the solution for the given day and part.
+func Find(day, part int) (Solution, bool) {
+	for _, s := range All {
+		if s.Day == day && s.Part == part {
+			return s, true
+		}
+	}
+	return Solution{}, false
+}
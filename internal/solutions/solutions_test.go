@@ -0,0 +1,135 @@
+package solutions
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// privateInput is the name used in testdata/answers.txt for a day's own
+// puzzle input, as opposed to one of the published examples.
+const privateInput = "input"
+
+// unknownAnswer stands for an answer that hasn't been filled in, as aoc new
+// records for the examples of a new day.
+const unknownAnswer = "?"
+
+type answer struct {
+	day, part int
+	input     string
+	want      string
+}
+
+func (a answer) path() string {
+	if a.input == privateInput {
+		return filepath.Join("..", "..", "cmd", fmt.Sprintf("day-%02d", a.day), privateInput)
+	}
+	return filepath.Join("..", fmt.Sprintf("day%02d", a.day), fmt.Sprintf("p%d", a.part), "testdata", a.input)
+}
+
+func readAnswers(t testing.TB) []answer {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "answers.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var answers []answer
+	scanner := bufio.NewScanner(f)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			t.Fatalf("answers.txt:%d: want 4 fields, got %d", lineNo, len(fields))
+		}
+		day, err := strconv.Atoi(fields[0])
+		if err != nil {
+			t.Fatalf("answers.txt:%d: day: %v", lineNo, err)
+		}
+		part, err := strconv.Atoi(fields[1])
+		if err != nil {
+			t.Fatalf("answers.txt:%d: part: %v", lineNo, err)
+		}
+		answers = append(answers, answer{day: day, part: part, input: fields[2], want: fields[3]})
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return answers
+}
+
+func runSolution(s Solution, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return s.Solve(f)
+}
+
+// TestGoldenAnswers runs every solution against its published examples and
+// the private puzzle input, comparing against testdata/answers.txt.
+func TestGoldenAnswers(t *testing.T) {
+	answers := readAnswers(t)
+
+	for _, a := range answers {
+		if _, ok := Find(a.day, a.part); !ok {
+			t.Errorf("answers.txt records day %d part %d, which has no solution", a.day, a.part)
+		}
+	}
+
+	for _, s := range All {
+		s := s
+		t.Run(fmt.Sprintf("day%02d/p%d", s.Day, s.Part), func(t *testing.T) {
+			var recorded []answer
+			examples := 0
+			for _, a := range answers {
+				if a.day != s.Day || a.part != s.Part {
+					continue
+				}
+				recorded = append(recorded, a)
+				if a.input != privateInput {
+					examples++
+				}
+			}
+			if examples == 0 {
+				t.Errorf("%s: no example answer recorded", s)
+			}
+			hasPrivate := len(recorded) > examples
+			if !hasPrivate {
+				recorded = append(recorded, answer{day: s.Day, part: s.Part, input: privateInput})
+			}
+
+			for _, a := range recorded {
+				a := a
+				t.Run(a.input, func(t *testing.T) {
+					got, err := runSolution(s, a.path())
+					if a.input == privateInput && errors.Is(err, fs.ErrNotExist) {
+						t.Skipf("%s: no private input at %s", s, a.path())
+					}
+					if err != nil {
+						t.Fatalf("%s on %s: %v", s, a.input, err)
+					}
+					if a.want == "" || a.want == unknownAnswer {
+						t.Skipf("%s: no answer recorded for %s, got %s", s, a.input, got)
+					}
+					if got != a.want {
+						t.Errorf("%s on %s regressed: got %s, want %s", s, a.input, got, a.want)
+					}
+				})
+			}
+		})
+	}
+}